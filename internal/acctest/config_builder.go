@@ -0,0 +1,247 @@
+package acctest
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ConfigValueGenerator returns the HCL expression for the value of an argument
+// of a generated configuration, or false to defer to the next generator.
+// The path of the argument is relative to the resource or data source,
+// with nested blocks separated by dots and without indexes, e.g. "attribute.name".
+type ConfigValueGenerator func(path string, s *schema.Schema) (string, bool)
+
+// ConfigValueAttribute returns a generator of the specified HCL expression,
+// e.g. "aws_dynamodb_table.test.arn", for the argument with the specified path.
+func ConfigValueAttribute(path, expression string) ConfigValueGenerator {
+	return func(p string, _ *schema.Schema) (string, bool) {
+		return expression, p == path
+	}
+}
+
+// ConfigValueName returns a generator of the specified name for the top-level
+// "name" argument.
+func ConfigValueName(name string) ConfigValueGenerator {
+	return ConfigValueAttribute("name", strconv.Quote(name))
+}
+
+// ConfigValueDefault generates a value for an argument of any type:
+// its schema default if set, ResourcePrefix for strings, 1 for numbers and
+// false for booleans. Lists and sets have one element and maps are empty.
+func ConfigValueDefault(path string, s *schema.Schema) (string, bool) {
+	if s.Default != nil {
+		if v, ok := s.Default.(string); ok {
+			return strconv.Quote(v), true
+		}
+
+		return fmt.Sprint(s.Default), true
+	}
+
+	switch s.Type {
+	case schema.TypeBool:
+		return "false", true
+	case schema.TypeFloat, schema.TypeInt:
+		return "1", true
+	case schema.TypeString:
+		return strconv.Quote(ResourcePrefix), true
+	case schema.TypeMap:
+		return "{}", true
+	case schema.TypeList, schema.TypeSet:
+		if elem, ok := s.Elem.(*schema.Schema); ok {
+			v, _ := ConfigValueDefault(path, elem)
+
+			return fmt.Sprintf("[%s]", v), true
+		}
+	}
+
+	return "", false
+}
+
+// ConfigBuilder generates minimal Terraform configurations from the schemas of
+// the provider's resources and data sources.
+// Only the required arguments and blocks are configured, along with the first
+// argument of each "exactly one of" or "at least one of" group with no argument configured.
+type ConfigBuilder struct {
+	generators []ConfigValueGenerator
+}
+
+// NewConfigBuilder returns a ConfigBuilder using the specified generators, in order,
+// for the values of arguments, followed by ConfigValueDefault.
+func NewConfigBuilder(generators ...ConfigValueGenerator) *ConfigBuilder {
+	return &ConfigBuilder{
+		generators: append(generators, ConfigValueDefault),
+	}
+}
+
+// Resource returns a minimal configuration of the resource with the specified type and name.
+func (b *ConfigBuilder) Resource(resourceType, resourceName string) (string, error) {
+	r, ok := Provider.ResourcesMap[resourceType]
+
+	if !ok {
+		return "", fmt.Errorf("resource type %s not found", resourceType)
+	}
+
+	return b.block("resource", resourceType, resourceName, r.Schema)
+}
+
+// DataSource returns a minimal configuration of the data source with the specified type and name.
+func (b *ConfigBuilder) DataSource(dataSourceType, dataSourceName string) (string, error) {
+	r, ok := Provider.DataSourcesMap[dataSourceType]
+
+	if !ok {
+		return "", fmt.Errorf("data source type %s not found", dataSourceType)
+	}
+
+	return b.block("data", dataSourceType, dataSourceName, r.Schema)
+}
+
+func (b *ConfigBuilder) block(kind, typeName, name string, s map[string]*schema.Schema) (string, error) {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s %q %q {\n", kind, typeName, name)
+
+	if err := b.body(&sb, "", s); err != nil {
+		return "", fmt.Errorf("generating %s configuration: %w", typeName, err)
+	}
+
+	sb.WriteString("}\n")
+
+	return string(hclwrite.Format([]byte(sb.String()))), nil
+}
+
+func (b *ConfigBuilder) body(sb *strings.Builder, prefix string, s map[string]*schema.Schema) error {
+	keys := configBuilderKeys(prefix, s)
+
+	var attributes, blocks []string
+
+	for _, k := range keys {
+		if _, ok := s[k].Elem.(*schema.Resource); ok {
+			blocks = append(blocks, k)
+		} else {
+			attributes = append(attributes, k)
+		}
+	}
+
+	for _, k := range attributes {
+		path := prefix + k
+		v, ok := b.value(path, s[k])
+
+		if !ok {
+			return fmt.Errorf("no value generated for argument %s", path)
+		}
+
+		fmt.Fprintf(sb, "%s = %s\n", k, v)
+	}
+
+	for _, k := range blocks {
+		n := s[k].MinItems
+		if n == 0 {
+			n = 1
+		}
+
+		for i := 0; i < n; i++ {
+			if len(attributes) > 0 || i > 0 || k != blocks[0] {
+				sb.WriteString("\n")
+			}
+
+			fmt.Fprintf(sb, "%s {\n", k)
+
+			if err := b.body(sb, prefix+k+".", s[k].Elem.(*schema.Resource).Schema); err != nil {
+				return err
+			}
+
+			sb.WriteString("}\n")
+		}
+	}
+
+	return nil
+}
+
+func (b *ConfigBuilder) value(path string, s *schema.Schema) (string, bool) {
+	for _, generator := range b.generators {
+		if v, ok := generator(path, s); ok {
+			return v, true
+		}
+	}
+
+	return "", false
+}
+
+// configBuilderKeys returns the sorted keys of the arguments to configure in the block with the specified path prefix.
+func configBuilderKeys(prefix string, s map[string]*schema.Schema) []string {
+	configured := make(map[string]bool)
+
+	for k, v := range s {
+		if v.Required || (v.Optional && v.MinItems > 0) {
+			configured[k] = true
+		}
+	}
+
+	// Visit the optional arguments in order so that the same member of a group is chosen.
+	var optional []string
+	for k, v := range s {
+		if v.Optional && !configured[k] {
+			optional = append(optional, k)
+		}
+	}
+	sort.Strings(optional)
+
+	for _, k := range optional {
+		group := append(append([]string{}, s[k].ExactlyOneOf...), s[k].AtLeastOneOf...)
+
+		if len(group) == 0 {
+			continue
+		}
+
+		found := false
+		for _, member := range group {
+			if k, ok := configBuilderGroupMemberKey(prefix, member); ok && configured[k] {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			configured[k] = true
+		}
+	}
+
+	keys := make([]string, 0, len(configured))
+	for k := range configured {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// configBuilderGroupMemberKey returns the key of a member of an "exactly one of" or "at least one of" group,
+// e.g. "block.0.name", relative to the block with the specified path prefix, e.g. "block.",
+// or false if the member is not an argument of the block.
+func configBuilderGroupMemberKey(prefix, member string) (string, bool) {
+	var parts []string
+	for _, part := range strings.Split(member, ".") {
+		if _, err := strconv.Atoi(part); err != nil {
+			parts = append(parts, part)
+		}
+	}
+
+	path := strings.Join(parts, ".")
+
+	if !strings.HasPrefix(path, prefix) {
+		return "", false
+	}
+
+	k := strings.TrimPrefix(path, prefix)
+
+	if k == "" || strings.Contains(k, ".") {
+		return "", false
+	}
+
+	return k, true
+}
@@ -0,0 +1,117 @@
+package acctest
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+func TestConfigBuilderResource(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		ResourceType string
+		Builder      *ConfigBuilder
+		Expected     string
+	}{
+		{
+			Name:         "required arguments",
+			ResourceType: "aws_dynamodb_table_export",
+			Builder:      NewConfigBuilder(ConfigValueAttribute("table_arn", "aws_dynamodb_table.test.arn")),
+			Expected: `resource "aws_dynamodb_table_export" "test" {
+  s3_bucket = "tf-acc-test"
+  table_arn = aws_dynamodb_table.test.arn
+}
+`,
+		},
+		{
+			Name:         "required blocks",
+			ResourceType: "aws_db_proxy",
+			Builder:      NewConfigBuilder(ConfigValueName("tf-acc-test-proxy"), ConfigValueAttribute("role_arn", "aws_iam_role.test.arn")),
+			Expected: `resource "aws_db_proxy" "test" {
+  engine_family  = "tf-acc-test"
+  name           = "tf-acc-test-proxy"
+  role_arn       = aws_iam_role.test.arn
+  vpc_subnet_ids = ["tf-acc-test"]
+
+  auth {
+  }
+}
+`,
+		},
+		{
+			Name:         "exactly one of",
+			ResourceType: "aws_db_proxy_target",
+			Builder:      NewConfigBuilder(),
+			Expected: `resource "aws_db_proxy_target" "test" {
+  db_cluster_identifier = "tf-acc-test"
+  db_proxy_name         = "tf-acc-test"
+  target_group_name     = "tf-acc-test"
+}
+`,
+		},
+		{
+			Name:         "nested exactly one of",
+			ResourceType: "aws_ebs_snapshot_import",
+			Builder:      NewConfigBuilder(),
+			Expected: `resource "aws_ebs_snapshot_import" "test" {
+  disk_container {
+    format = "tf-acc-test"
+    url    = "tf-acc-test"
+  }
+}
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := testCase.Builder.Resource(testCase.ResourceType, "test")
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("expected:\n%s\ngot:\n%s", testCase.Expected, got)
+			}
+		})
+	}
+}
+
+func TestConfigBuilderResource_notFound(t *testing.T) {
+	if _, err := NewConfigBuilder().Resource("aws_not_a_resource", "test"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+// Every resource and data source must have a minimal configuration that is valid HCL.
+func TestConfigBuilderProvider(t *testing.T) {
+	builder := NewConfigBuilder()
+
+	for resourceType := range Provider.ResourcesMap {
+		config, err := builder.Resource(resourceType, "test")
+
+		if err != nil {
+			t.Errorf("resource %s: %s", resourceType, err)
+			continue
+		}
+
+		if _, diags := hclsyntax.ParseConfig([]byte(config), resourceType+".tf", hcl.InitialPos); diags.HasErrors() {
+			t.Errorf("resource %s: %s\n%s", resourceType, diags.Error(), config)
+		}
+	}
+
+	for dataSourceType := range Provider.DataSourcesMap {
+		config, err := builder.DataSource(dataSourceType, "test")
+
+		if err != nil {
+			t.Errorf("data source %s: %s", dataSourceType, err)
+			continue
+		}
+
+		if _, diags := hclsyntax.ParseConfig([]byte(config), dataSourceType+".tf", hcl.InitialPos); diags.HasErrors() {
+			t.Errorf("data source %s: %s\n%s", dataSourceType, diags.Error(), config)
+		}
+	}
+}
@@ -0,0 +1,320 @@
+package acctest
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// importStateFixture is the recorded state of a resource after import,
+// read from testdata/import/<resource type>.json.
+type importStateFixture struct {
+	// ImportID is the ID passed to the importer. Defaults to ID.
+	ImportID string `json:"import_id,omitempty"`
+
+	// ID is empty if the importer generates a unique ID.
+	ID         string            `json:"id,omitempty"`
+	Attributes map[string]string `json:"attributes"`
+
+	// Fakes maps the AWS API operations called by the importer and the read function
+	// to the JSON of their outputs, e.g. {"DescribeTable": {"Table": {"TableName": "example"}}}.
+	// If set, the imported resource is read and must have exactly the recorded attributes.
+	Fakes map[string]json.RawMessage `json:"fakes,omitempty"`
+}
+
+// Every resource with an Importer must round-trip its recorded state through ImportState:
+// the state must be representable by the resource's schema, the importer must return
+// a single resource with the recorded ID and attributes, and the recorded attributes
+// must survive being set on the imported resource. With fakes, the imported resource
+// must also read back the recorded attributes.
+// Importers that call AWS APIs without fakes fail the test.
+// Resources with a passthrough importer, which only keeps the ID, need no fixture.
+// Other resources without a fixture fail the test.
+func TestImportState(t *testing.T) {
+	const dir = "testdata/import"
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fixtures := make(map[string]string)
+	for _, file := range files {
+		fixtures[strings.TrimSuffix(filepath.Base(file), ".json")] = file
+	}
+
+	for resourceType := range fixtures {
+		if r, ok := Provider.ResourcesMap[resourceType]; !ok || r.Importer == nil {
+			t.Errorf("fixture for resource type %s, which does not exist or cannot be imported", resourceType)
+		}
+	}
+
+	var resourceTypes []string
+	for resourceType, r := range Provider.ResourcesMap {
+		if r.Importer != nil {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+	sort.Strings(resourceTypes)
+
+	var passthrough int
+	defer func() {
+		t.Logf("%d of %d importable resource types have a passthrough importer and no fixture", passthrough, len(resourceTypes))
+	}()
+
+	for _, resourceType := range resourceTypes {
+		resourceType := resourceType
+
+		t.Run(resourceType, func(t *testing.T) {
+			r := Provider.ResourcesMap[resourceType]
+			file, ok := fixtures[resourceType]
+
+			if !ok && testImportStatePassthrough(r) {
+				passthrough++
+				t.Skip("passthrough importer")
+			}
+
+			if !ok {
+				t.Fatalf("no fixture in %s, add one (see docs/contributing/running-and-writing-acceptance-tests.md)", dir)
+			}
+
+			b, err := os.ReadFile(file)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var fixture importStateFixture
+
+			if err := json.Unmarshal(b, &fixture); err != nil {
+				t.Fatalf("error reading %s: %s", file, err)
+			}
+
+			importID := fixture.ImportID
+			if importID == "" {
+				importID = fixture.ID
+			}
+
+			fakes := make(UnitTestFakes)
+			for name, output := range fixture.Fakes {
+				output := output
+				fakes[name] = func(interface{}) (interface{}, error) { return output, nil }
+			}
+
+			meta := UnitTestClient(t, fakes)
+			state := UnitTestState(fixture.ID, fixture.Attributes)
+
+			testImportStateSchema(t, r, state)
+			imported := testImportStateImporter(t, resourceType, r, importID, state, meta)
+			testImportStateSet(t, r, imported, state)
+
+			if len(fixture.Fakes) > 0 {
+				testImportStateRead(t, r, imported, state, meta)
+			}
+		})
+	}
+}
+
+// testImportStateSchema verifies that the state is unchanged by conversion to the resource's schema type.
+func testImportStateSchema(t *testing.T, r *schema.Resource, state *terraform.InstanceState) {
+	t.Helper()
+
+	ty := r.CoreConfigSchema().ImpliedType()
+	v, err := state.AttrsAsObjectValue(ty)
+
+	if err != nil {
+		t.Fatalf("error converting state: %s", err)
+	}
+
+	got, err := terraform.NewInstanceStateShimmedFromValue(v, r.SchemaVersion).AttrsAsObjectValue(ty)
+
+	if err != nil {
+		t.Fatalf("error converting state: %s", err)
+	}
+
+	if !got.RawEquals(v) {
+		t.Errorf("state changed by conversion:\nexpected: %#v\ngot: %#v", v, got)
+	}
+
+	for k := range state.Attributes {
+		if !testImportStateAttributeInSchema(r.Schema, k) {
+			t.Errorf("attribute %s not in schema", k)
+		}
+	}
+}
+
+// testImportStateImporter verifies that the importer returns the recorded ID and attributes.
+func testImportStateImporter(t *testing.T, resourceType string, r *schema.Resource, importID string, state *terraform.InstanceState, meta interface{}) *schema.ResourceData {
+	t.Helper()
+
+	d := r.Data(nil)
+	d.SetId(importID)
+	d.SetType(resourceType)
+
+	var results []*schema.ResourceData
+	var err error
+
+	switch {
+	case r.Importer.State != nil:
+		results, err = r.Importer.State(d, meta)
+	case r.Importer.StateContext != nil:
+		results, err = r.Importer.StateContext(context.Background(), d, meta)
+	default:
+		t.Fatal("importer has no state function")
+	}
+
+	if err != nil {
+		t.Fatalf("error importing %s: %s", importID, err)
+	}
+
+	if len(results) != 1 {
+		t.Fatalf("expected 1 imported resource, got %d", len(results))
+	}
+
+	imported := results[0].State()
+
+	if imported == nil || imported.ID == "" || (state.ID != "" && imported.ID != state.ID) {
+		t.Fatalf("expected imported ID %q, got %v", state.ID, imported)
+	}
+
+	for k, v := range imported.Attributes {
+		if k == "id" || strings.HasPrefix(k, "timeouts.") {
+			continue
+		}
+
+		if expected, ok := state.Attributes[k]; !ok || v != expected {
+			t.Errorf("expected imported attribute %s to be %q, got %q", k, expected, v)
+		}
+	}
+
+	return results[0]
+}
+
+// testImportStateSet verifies that the recorded attributes survive being set on the
+// imported resource through its schema, as the read function sets them.
+func testImportStateSet(t *testing.T, r *schema.Resource, imported *schema.ResourceData, state *terraform.InstanceState) {
+	t.Helper()
+
+	recorded := r.Data(state)
+
+	for k := range r.Schema {
+		if !testImportStateHasAttribute(state, k) {
+			continue
+		}
+
+		if err := imported.Set(k, recorded.Get(k)); err != nil {
+			t.Errorf("error setting %s: %s", k, err)
+		}
+	}
+
+	got := imported.State()
+
+	if got == nil {
+		t.Fatal("imported resource has no state")
+	}
+
+	for k, expected := range state.Attributes {
+		if k == "id" {
+			continue
+		}
+
+		if v, ok := got.Attributes[k]; !ok || v != expected {
+			t.Errorf("expected attribute %s to be %q, got %q", k, expected, v)
+		}
+	}
+}
+
+// testImportStateRead verifies that reading the imported resource results in exactly the recorded state.
+func testImportStateRead(t *testing.T, r *schema.Resource, imported *schema.ResourceData, state *terraform.InstanceState, meta interface{}) {
+	t.Helper()
+
+	d, err := UnitTestRead(t, r, imported.State(), meta)
+
+	if err != nil {
+		t.Fatalf("error reading imported resource: %s", err)
+	}
+
+	got := d.State()
+
+	if got == nil || got.ID != state.ID {
+		t.Fatalf("expected read ID %q, got %v", state.ID, got)
+	}
+
+	for k, expected := range state.Attributes {
+		if v, ok := got.Attributes[k]; !ok || v != expected {
+			t.Errorf("expected read attribute %s to be %q, got %q", k, expected, v)
+		}
+	}
+
+	for k, v := range got.Attributes {
+		if _, ok := state.Attributes[k]; !ok && !strings.HasPrefix(k, "timeouts.") {
+			t.Errorf("unexpected read attribute %s: %q", k, v)
+		}
+	}
+}
+
+// testImportStatePassthrough returns whether the resource's importer only keeps the ID.
+func testImportStatePassthrough(r *schema.Resource) bool {
+	switch {
+	case r.Importer.StateContext != nil:
+		return reflect.ValueOf(r.Importer.StateContext).Pointer() == reflect.ValueOf(schema.ImportStatePassthroughContext).Pointer()
+	case r.Importer.State != nil:
+		return reflect.ValueOf(r.Importer.State).Pointer() == reflect.ValueOf(schema.ImportStatePassthrough).Pointer()
+	}
+
+	return false
+}
+
+// testImportStateHasAttribute returns whether the state has the top-level attribute.
+func testImportStateHasAttribute(state *terraform.InstanceState, k string) bool {
+	for _, key := range []string{k, k + ".#", k + ".%"} {
+		if _, ok := state.Attributes[key]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func testImportStateAttributeInSchema(s map[string]*schema.Schema, k string) bool {
+	parts := strings.Split(k, ".")
+
+	if parts[0] == "id" && len(parts) == 1 {
+		return true
+	}
+
+	v, ok := s[parts[0]]
+
+	if !ok {
+		return false
+	}
+
+	if len(parts) == 1 {
+		return true
+	}
+
+	// Count of a list, set or map.
+	if len(parts) == 2 && (parts[1] == "#" || parts[1] == "%") {
+		return v.Type == schema.TypeList || v.Type == schema.TypeSet || v.Type == schema.TypeMap
+	}
+
+	switch elem := v.Elem.(type) {
+	case *schema.Resource:
+		if len(parts) < 3 {
+			return false
+		}
+
+		return testImportStateAttributeInSchema(elem.Schema, strings.Join(parts[2:], "."))
+	default:
+		return len(parts) == 2
+	}
+}
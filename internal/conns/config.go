@@ -5,10 +5,12 @@ import (
 	"log"
 	"strings"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/appconfig"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
@@ -169,31 +171,12 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		}
 	}
 
-	DNSSuffix := "amazonaws.com"
-	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
-		DNSSuffix = p.DNSSuffix()
-	}
-
-	client := c.clientConns(sess)
+	client := c.ClientFromSession(sess)
 
 	client.AccountID = accountID
-	client.DefaultTagsConfig = c.DefaultTagsConfig
-	client.DNSSuffix = DNSSuffix
-	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
-	client.Region = c.Region
-	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
-	client.Session = sess
-	client.TerraformVersion = c.TerraformVersion
 
-	client.Route53DomainsConn = route53domains.NewFromConfig(cfg, func(o *route53domains.Options) {
-		if endpoint := c.Endpoints[names.Route53Domains]; endpoint != "" {
-			o.EndpointResolver = route53domains.EndpointResolverFromURL(endpoint)
-		} else if partition == endpoints.AwsPartitionID {
-			// Route 53 Domains is only available in AWS Commercial us-east-1 Region.
-			o.Region = endpoints.UsEast1RegionID
-		}
-	})
+	c.ClientsFromConfig(client, cfg)
 
 	// sts
 	stsConfig := &aws.Config{
@@ -535,3 +518,44 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 
 	return client, nil
 }
+
+// ClientFromSession returns an AWSClient with the generated AWS SDK for Go v1
+// service clients created from the specified session.
+// No AWS API calls are made, so the account ID is not set and service clients
+// that require customization (e.g. S3 and STS) are not created.
+// Unit tests use it with a session whose request handlers are replaced by fakes.
+func (c *Config) ClientFromSession(sess *session.Session) *AWSClient {
+	DNSSuffix := "amazonaws.com"
+	partition := endpoints.AwsPartitionID
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
+		DNSSuffix = p.DNSSuffix()
+		partition = p.ID()
+	}
+
+	client := c.clientConns(sess)
+
+	client.DefaultTagsConfig = c.DefaultTagsConfig
+	client.DNSSuffix = DNSSuffix
+	client.IgnoreTagsConfig = c.IgnoreTagsConfig
+	client.Partition = partition
+	client.Region = c.Region
+	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
+	client.Session = sess
+	client.TerraformVersion = c.TerraformVersion
+
+	return client
+}
+
+// ClientsFromConfig creates the AWS SDK for Go v2 service clients of the AWSClient
+// from the specified configuration.
+// Unit tests use it with a configuration whose middleware is replaced by fakes.
+func (c *Config) ClientsFromConfig(client *AWSClient, cfg aws_sdkv2.Config) {
+	client.Route53DomainsConn = route53domains.NewFromConfig(cfg, func(o *route53domains.Options) {
+		if endpoint := c.Endpoints[names.Route53Domains]; endpoint != "" {
+			o.EndpointResolver = route53domains.EndpointResolverFromURL(endpoint)
+		} else if client.Partition == endpoints.AwsPartitionID {
+			// Route 53 Domains is only available in AWS Commercial us-east-1 Region.
+			o.Region = endpoints.UsEast1RegionID
+		}
+	})
+}
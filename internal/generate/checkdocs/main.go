@@ -0,0 +1,479 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+var (
+	docsDir  = flag.String("Docs", "website/docs", "path of the website/docs directory")
+	types    = flag.String("Types", "", "comma-separated resource and data source types to check, defaults to all")
+	skeleton = flag.Bool("Skeleton", false, "whether to print a skeleton Argument Reference section instead of checking")
+	forceNew = flag.Bool("ForceNew", false, "whether to report arguments that force a new resource but are not documented as such")
+)
+
+// e.g. "* `name` - (Optional) Name", "* `name` (Optional) - Name" or "* `name` (Optional) Name"
+var docBulletRegexp = regexp.MustCompile("^(\\s*)[*-] `([A-Za-z0-9_.*]+)`\\s*(?:\\(([^)]*)\\)\\s*-?|-\\s*(?:\\(([^)]*)\\))?)")
+
+var docBlockNameRegexp = regexp.MustCompile("[^a-z0-9_]+")
+
+// e.g. "Forces new resource", "Changing this forces a new resource to be created" or "will force a new cluster to be created"
+var docForcesNewRegexp = regexp.MustCompile(`(?i)\bforces?\s+(?:the\s+)?(?:creation\s+of\s+)?(?:a\s+)?new\b`)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+// schemaType is a resource or data source type to check.
+type schemaType struct {
+	Name       string
+	DataSource bool
+	Resource   *schema.Resource
+}
+
+func (t schemaType) kind() string {
+	if t.DataSource {
+		return "data source"
+	}
+
+	return "resource"
+}
+
+func (t schemaType) docDir() string {
+	if t.DataSource {
+		return "d"
+	}
+
+	return "r"
+}
+
+// docArgument is an argument or attribute listed in a documentation page.
+type docArgument struct {
+	// Section is the top-level section, e.g. "Argument Reference", in which the argument is listed.
+	Section string
+	// Block is the heading of the subsection, or the name of the parent bullet, in which the argument is listed.
+	Block string
+	// Label is the text in parentheses following the argument name, e.g. "Required, Forces new resource".
+	Label string
+	// Description is the text following the label, including continuation lines.
+	Description string
+}
+
+// forcesNew returns whether the argument is documented as forcing a new resource,
+// in its label or in the prose of its description, e.g. "Changing this forces a new resource".
+func (a docArgument) forcesNew() bool {
+	return docForcesNewRegexp.MatchString(a.Label) || docForcesNewRegexp.MatchString(a.Description)
+}
+
+// inBlock returns whether the argument is listed in the subsection or under the bullet of the named block.
+func (a docArgument) inBlock(name string) bool {
+	// e.g. "`vpc_config` Arguments" or "Vpc Config"
+	block := docBlockNameRegexp.ReplaceAllString(strings.ToLower(a.Block), "_")
+
+	return strings.Contains("_"+block+"_", "_"+name+"_")
+}
+
+// doc is a parsed documentation page.
+type doc struct {
+	Path string
+	// AttributesByReference is whether the attributes are documented by a link to a resource page.
+	AttributesByReference bool
+	// Arguments maps the names of the listed arguments and attributes, as written, to their listings.
+	Arguments map[string][]docArgument
+	// Paths maps the names of the listed arguments and attributes, without list indexes and wildcards, to their listings.
+	Paths map[string][]docArgument
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	p := provider.Provider()
+
+	var schemaTypes []schemaType
+	for name, r := range p.ResourcesMap {
+		schemaTypes = append(schemaTypes, schemaType{Name: name, Resource: r})
+	}
+	for name, r := range p.DataSourcesMap {
+		schemaTypes = append(schemaTypes, schemaType{Name: name, DataSource: true, Resource: r})
+	}
+	sort.Slice(schemaTypes, func(i, j int) bool {
+		if schemaTypes[i].Name == schemaTypes[j].Name {
+			return !schemaTypes[i].DataSource
+		}
+
+		return schemaTypes[i].Name < schemaTypes[j].Name
+	})
+
+	if *types != "" {
+		filter := make(map[string]bool)
+		for _, v := range strings.Split(*types, ",") {
+			filter[strings.TrimSpace(v)] = true
+		}
+
+		var filtered []schemaType
+		for _, t := range schemaTypes {
+			if filter[t.Name] {
+				filtered = append(filtered, t)
+			}
+		}
+		schemaTypes = filtered
+
+		if len(schemaTypes) == 0 {
+			log.Fatalf("no resource or data source types found in %s", *types)
+		}
+	}
+
+	if *skeleton {
+		for _, t := range schemaTypes {
+			fmt.Printf("<!-- %s %s -->\n\n", t.kind(), t.Name)
+			fmt.Print(argumentReference(t))
+		}
+
+		return
+	}
+
+	var issueCount, fileCount int
+
+	for _, t := range schemaTypes {
+		d, err := readDoc(*docsDir, t)
+
+		if err != nil {
+			fmt.Printf("%s %s: %s\n", t.kind(), t.Name, err)
+			issueCount++
+			continue
+		}
+
+		fileCount++
+
+		for _, issue := range checkDoc(t, d) {
+			fmt.Printf("%s: %s\n", d.Path, issue)
+			issueCount++
+		}
+	}
+
+	fmt.Printf("Checked %d documentation pages, found %d issues\n", fileCount, issueCount)
+
+	if issueCount > 0 {
+		os.Exit(1)
+	}
+}
+
+// readDoc reads the documentation page of the specified type.
+func readDoc(dir string, t schemaType) (*doc, error) {
+	name := strings.TrimPrefix(t.Name, "aws_")
+
+	for _, ext := range []string{".html.markdown", ".markdown"} {
+		path := filepath.Join(dir, t.docDir(), name+ext)
+
+		f, err := os.Open(path)
+
+		if os.IsNotExist(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("opening documentation: %w", err)
+		}
+
+		defer f.Close()
+
+		return parseDoc(path, f)
+	}
+
+	return nil, fmt.Errorf("documentation not found in %s", filepath.Join(dir, t.docDir()))
+}
+
+// parseDoc parses a documentation page.
+func parseDoc(path string, r io.Reader) (*doc, error) {
+	d := &doc{
+		Path:      path,
+		Arguments: make(map[string][]docArgument),
+		Paths:     make(map[string][]docArgument),
+	}
+
+	var section, subsection string
+	// Names of the enclosing bullets, by indentation.
+	var parents []string
+	var indents []int
+	// The last listed argument, whose description continues on the following lines.
+	var last *docArgument
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if strings.HasPrefix(line, "## ") {
+			section = strings.TrimSpace(strings.TrimPrefix(line, "## "))
+			subsection = ""
+			parents, indents, last = nil, nil, nil
+			continue
+		}
+
+		if strings.HasPrefix(line, "###") && strings.HasPrefix(strings.TrimLeft(line, "#"), " ") {
+			subsection = strings.TrimSpace(strings.TrimLeft(line, "#"))
+			parents, indents, last = nil, nil, nil
+			continue
+		}
+
+		switch {
+		case section == "Timeouts", section == "Import", section == "Example Usage", subsection == "Timeouts":
+			continue
+		case strings.HasPrefix(section, "Attribute") && strings.Contains(line, "/r/"):
+			d.AttributesByReference = true
+		}
+
+		m := docBulletRegexp.FindStringSubmatch(line)
+
+		if m == nil {
+			if strings.TrimSpace(line) == "" {
+				last = nil
+			} else if last != nil {
+				last.Description += " " + strings.TrimSpace(line)
+			}
+
+			continue
+		}
+
+		indent := len(strings.ReplaceAll(m[1], "\t", "    "))
+		for len(indents) > 0 && indents[len(indents)-1] >= indent {
+			parents, indents = parents[:len(parents)-1], indents[:len(indents)-1]
+		}
+
+		block := subsection
+		if len(parents) > 0 {
+			block = parents[len(parents)-1]
+		}
+
+		label := m[3]
+		if label == "" {
+			label = m[4]
+		}
+
+		arg := &docArgument{Section: section, Block: block, Label: label, Description: strings.TrimSpace(line[len(m[0]):])}
+		d.Arguments[m[2]] = append(d.Arguments[m[2]], *arg)
+		last = &d.Arguments[m[2]][len(d.Arguments[m[2]])-1]
+		parents, indents = append(parents, m[2]), append(indents, indent)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading documentation (%s): %w", path, err)
+	}
+
+	for name, v := range d.Arguments {
+		// e.g. `replica.*.arn` or `certificate_authority.0.data`
+		var parts []string
+		for _, part := range strings.Split(name, ".") {
+			if part != "*" && !isIndex(part) {
+				parts = append(parts, part)
+			}
+		}
+
+		path := strings.Join(parts, ".")
+		d.Paths[path] = append(d.Paths[path], v...)
+	}
+
+	return d, nil
+}
+
+// argument returns the listing of the argument or attribute at the path in the schema.
+// Nested arguments listed by name alone must be listed in the section or under the bullet of their block.
+func (d *doc) argument(prefix, k string) (docArgument, bool) {
+	if v := d.Paths[prefix+k]; len(v) > 0 {
+		return v[0], true
+	}
+
+	if prefix == "" {
+		return docArgument{}, false
+	}
+
+	// e.g. "encryption_config.0.provider." is in the block "provider"
+	var block string
+	for _, part := range strings.Split(strings.TrimSuffix(prefix, "."), ".") {
+		if part != "*" && !isIndex(part) {
+			block = part
+		}
+	}
+
+	for _, v := range d.Arguments[k] {
+		if v.inBlock(block) {
+			return v, true
+		}
+	}
+
+	return docArgument{}, false
+}
+
+// checkDoc returns the missing and stale arguments and attributes of the documentation page.
+func checkDoc(t schemaType, d *doc) []string {
+	var issues []string
+
+	// Names of the arguments and attributes at any level.
+	names := map[string]bool{"id": true}
+
+	var walk func(prefix string, s map[string]*schema.Schema)
+	walk = func(prefix string, s map[string]*schema.Schema) {
+		for _, k := range sortedKeys(s) {
+			v := s[k]
+			path := prefix + k
+			names[k] = true
+
+			arg, documented := d.argument(prefix, k)
+			isArgument := v.Required || v.Optional
+			forcesNew := arg.forcesNew()
+
+			switch {
+			case !documented && v.Deprecated != "":
+				// Deprecated arguments may be removed from the documentation.
+			case !documented && v.Computed && d.AttributesByReference:
+				// Attributes documented by a link to a resource page.
+			case !documented && isArgument:
+				issues = append(issues, fmt.Sprintf("missing argument `%s`", path))
+			case !documented:
+				issues = append(issues, fmt.Sprintf("missing attribute `%s`", path))
+			case prefix == "" && isArgument && !v.Computed && arg.Section != "" && !strings.HasPrefix(arg.Section, "Argument"):
+				issues = append(issues, fmt.Sprintf("argument `%s` listed in %s", path, arg.Section))
+			case v.Required && strings.Contains(arg.Label, "Optional"):
+				issues = append(issues, fmt.Sprintf("argument `%s` is required but documented as optional", path))
+			case v.Optional && strings.Contains(arg.Label, "Required"):
+				issues = append(issues, fmt.Sprintf("argument `%s` is optional but documented as required", path))
+			case *forceNew && !t.DataSource && isArgument && arg.Label != "" && v.ForceNew && !forcesNew:
+				issues = append(issues, fmt.Sprintf("argument `%s` forces a new resource but is not documented as such", path))
+			case !t.DataSource && isArgument && !v.ForceNew && docForcesNewRegexp.MatchString(arg.Label):
+				issues = append(issues, fmt.Sprintf("argument `%s` is documented as forcing a new resource but can be updated in-place", path))
+			}
+
+			if elem, ok := v.Elem.(*schema.Resource); ok {
+				walk(path+".", elem.Schema)
+			}
+		}
+	}
+	walk("", t.Resource.Schema)
+
+	var stale []string
+	for name := range d.Arguments {
+		// e.g. `replica.*.arn` or `certificate_authority.0.data`
+		var last string
+		for _, part := range strings.Split(name, ".") {
+			if part != "*" && !isIndex(part) {
+				last = part
+			}
+		}
+
+		if !names[last] {
+			stale = append(stale, name)
+		}
+	}
+	sort.Strings(stale)
+
+	for _, name := range stale {
+		issues = append(issues, fmt.Sprintf("documented `%s` not in schema", name))
+	}
+
+	return issues
+}
+
+// argumentReference returns a skeleton Argument Reference section of the specified type.
+func argumentReference(t schemaType) string {
+	var sb strings.Builder
+
+	sb.WriteString("## Argument Reference\n\nThe following arguments are supported:\n\n")
+
+	var blocks []string
+	var blockSchemas []map[string]*schema.Schema
+
+	var write func(path string, s map[string]*schema.Schema)
+	write = func(path string, s map[string]*schema.Schema) {
+		for _, k := range sortedKeys(s) {
+			v := s[k]
+
+			if !v.Required && !v.Optional {
+				continue
+			}
+
+			fmt.Fprintf(&sb, "* `%s` - (%s)", k, argumentLabel(t, v))
+
+			if v.Description != "" {
+				fmt.Fprintf(&sb, " %s", v.Description)
+			}
+
+			if v.Default != nil {
+				fmt.Fprintf(&sb, " Defaults to `%v`.", v.Default)
+			}
+
+			if elem, ok := v.Elem.(*schema.Resource); ok {
+				sb.WriteString(" Detailed below.")
+				blocks = append(blocks, path+k)
+				blockSchemas = append(blockSchemas, elem.Schema)
+			}
+
+			sb.WriteString("\n")
+		}
+	}
+	write("", t.Resource.Schema)
+
+	for i := 0; i < len(blocks); i++ {
+		name := blocks[i]
+		fmt.Fprintf(&sb, "\n### %s\n\nThe `%s` configuration block supports the following arguments:\n\n", name, name[strings.LastIndex(name, ".")+1:])
+		write(name+".", blockSchemas[i])
+	}
+
+	sb.WriteString("\n")
+
+	return sb.String()
+}
+
+func argumentLabel(t schemaType, s *schema.Schema) string {
+	label := "Optional"
+	if s.Required {
+		label = "Required"
+	}
+
+	if s.ForceNew && !t.DataSource {
+		label += ", Forces new resource"
+	}
+
+	if s.Deprecated != "" {
+		label += ", **Deprecated**"
+	}
+
+	return label
+}
+
+func sortedKeys(s map[string]*schema.Schema) []string {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func isIndex(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
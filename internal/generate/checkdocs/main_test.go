@@ -0,0 +1,367 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestParseDoc(t *testing.T) {
+	testCases := []struct {
+		name string
+		doc  string
+		want map[string][]docArgument
+	}{
+		{
+			name: "arguments",
+			doc: `## Argument Reference
+
+* ` + "`name`" + ` - (Required, Forces new resource) Name of the table.
+* ` + "`tags`" + ` - (Optional) Map of tags.
+`,
+			want: map[string][]docArgument{
+				"name": {{Section: "Argument Reference", Label: "Required, Forces new resource", Description: "Name of the table."}},
+				"tags": {{Section: "Argument Reference", Label: "Optional", Description: "Map of tags."}},
+			},
+		},
+		{
+			name: "label before dash",
+			doc: `## Argument Reference
+
+* ` + "`policy_id`" + ` (Optional) - ID for the policy document.
+* ` + "`test`" + ` (Required) Name of the condition operator.
+`,
+			want: map[string][]docArgument{
+				"policy_id": {{Section: "Argument Reference", Label: "Optional", Description: "ID for the policy document."}},
+				"test":      {{Section: "Argument Reference", Label: "Required", Description: "Name of the condition operator."}},
+			},
+		},
+		{
+			name: "continuation lines",
+			doc: `## Argument Reference
+
+* ` + "`engine_version`" + ` - (Optional) The engine version to use.
+Changing this forces a new resource.
+
+Not a continuation.
+`,
+			want: map[string][]docArgument{
+				"engine_version": {{Section: "Argument Reference", Label: "Optional", Description: "The engine version to use. Changing this forces a new resource."}},
+			},
+		},
+		{
+			name: "subsection",
+			doc: `## Argument Reference
+
+### ttl
+
+* ` + "`enabled`" + ` - (Optional) Whether TTL is enabled.
+
+#### ` + "`vpc_config`" + ` Arguments
+
+* ` + "`subnet_ids`" + ` - (Required) Subnets.
+
+##### container_override
+
+* ` + "`cpu`" + ` - (Optional) CPU units.
+`,
+			want: map[string][]docArgument{
+				"cpu":        {{Section: "Argument Reference", Block: "container_override", Label: "Optional", Description: "CPU units."}},
+				"enabled":    {{Section: "Argument Reference", Block: "ttl", Label: "Optional", Description: "Whether TTL is enabled."}},
+				"subnet_ids": {{Section: "Argument Reference", Block: "`vpc_config` Arguments", Label: "Required", Description: "Subnets."}},
+			},
+		},
+		{
+			name: "parent bullets",
+			doc: `## Attributes Reference
+
+* ` + "`results`" + ` - Results of the simulation.
+    * ` + "`matched_statements`" + ` - Matched statements.
+        * ` + "`source_policy_id`" + ` - Policy ID.
+    * ` + "`decision`" + ` - Decision.
+* ` + "`id`" + ` - ID.
+`,
+			want: map[string][]docArgument{
+				"results":            {{Section: "Attributes Reference", Description: "Results of the simulation."}},
+				"matched_statements": {{Section: "Attributes Reference", Block: "results", Description: "Matched statements."}},
+				"source_policy_id":   {{Section: "Attributes Reference", Block: "matched_statements", Description: "Policy ID."}},
+				"decision":           {{Section: "Attributes Reference", Block: "results", Description: "Decision."}},
+				"id":                 {{Section: "Attributes Reference", Description: "ID."}},
+			},
+		},
+		{
+			name: "ignored sections",
+			doc: `## Example Usage
+
+* ` + "`example`" + ` - (Optional) Not an argument.
+
+## Timeouts
+
+* ` + "`create`" + ` - (Default ` + "`10m`" + `)
+
+## Import
+
+* ` + "`id`" + ` - Not an attribute.
+`,
+			want: map[string][]docArgument{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := parseDoc("test.html.markdown", strings.NewReader(testCase.doc))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got.Arguments, testCase.want) {
+				t.Errorf("got %#v, want %#v", got.Arguments, testCase.want)
+			}
+		})
+	}
+}
+
+func TestDocArgumentForcesNew(t *testing.T) {
+	testCases := []struct {
+		name string
+		arg  docArgument
+		want bool
+	}{
+		{
+			name: "label",
+			arg:  docArgument{Label: "Required, Forces new resource"},
+			want: true,
+		},
+		{
+			name: "prose",
+			arg:  docArgument{Label: "Required", Description: "Name of the cluster. Changing this forces a new resource to be created."},
+			want: true,
+		},
+		{
+			name: "prose block",
+			arg:  docArgument{Label: "Optional", Description: "Changing it will force a new cluster to be created."},
+			want: true,
+		},
+		{
+			name: "prose creation",
+			arg:  docArgument{Label: "Optional", Description: "Updating this forces creation of a new resource."},
+			want: true,
+		},
+		{
+			name: "none",
+			arg:  docArgument{Label: "Optional", Description: "Whether to create a new version on update."},
+			want: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := testCase.arg.forcesNew(); got != testCase.want {
+				t.Errorf("got %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestCheckDoc(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ttl": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"replica": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		forceNew bool
+		doc      string
+		want     []string
+	}{
+		{
+			name: "complete",
+			doc: `## Argument Reference
+
+* ` + "`name`" + ` - (Required, Forces new resource) Name.
+* ` + "`ttl`" + ` - (Optional) TTL. Detailed below.
+
+### ttl
+
+* ` + "`enabled`" + ` - (Optional) Whether TTL is enabled.
+
+## Attributes Reference
+
+* ` + "`arn`" + ` - ARN.
+* ` + "`replica`" + ` - Replicas.
+    * ` + "`region_name`" + ` - Region.
+`,
+		},
+		{
+			name: "nested paths",
+			doc: `## Argument Reference
+
+* ` + "`name`" + ` - (Required) Name. Changing this forces a new resource.
+* ` + "`ttl.enabled`" + ` - (Optional) Whether TTL is enabled.
+
+## Attributes Reference
+
+* ` + "`arn`" + ` - ARN.
+* ` + "`replica.*.region_name`" + ` - Region.
+`,
+			forceNew: true,
+			want: []string{
+				"missing attribute `replica`",
+				"missing argument `ttl`",
+			},
+		},
+		{
+			name: "nested argument in wrong block",
+			doc: `## Argument Reference
+
+* ` + "`name`" + ` - (Required) Name.
+* ` + "`ttl`" + ` - (Optional) TTL.
+
+### replica
+
+* ` + "`enabled`" + ` - (Optional) Whether TTL is enabled.
+
+## Attributes Reference
+
+* ` + "`arn`" + ` - ARN.
+* ` + "`replica`" + ` - Replicas.
+* ` + "`region_name`" + ` - Region.
+`,
+			want: []string{
+				"missing attribute `replica.region_name`",
+				"missing argument `ttl.enabled`",
+			},
+		},
+		{
+			name: "attribute sections",
+			doc: `## Argument Reference
+
+* ` + "`ttl`" + ` - (Optional) TTL.
+    * ` + "`enabled`" + ` - (Optional) Whether TTL is enabled.
+
+## Attributes Reference
+
+* ` + "`arn`" + ` - ARN.
+* ` + "`name`" + ` - Name.
+* ` + "`replica`" + ` - Replicas.
+    * ` + "`region_name`" + ` - Region.
+`,
+			want: []string{
+				"argument `name` listed in Attributes Reference",
+			},
+		},
+		{
+			name: "labels",
+			doc: `## Argument Reference
+
+* ` + "`name`" + ` - (Optional) Name.
+* ` + "`ttl`" + ` - (Required, Forces new resource) TTL.
+    * ` + "`enabled`" + ` - (Optional) Whether TTL is enabled.
+* ` + "`stale`" + ` - (Optional) Removed.
+
+## Attributes Reference
+
+* ` + "`arn`" + ` - ARN.
+* ` + "`replica`" + ` - Replicas.
+    * ` + "`region_name`" + ` - Region.
+`,
+			want: []string{
+				"argument `name` is required but documented as optional",
+				"argument `ttl` is optional but documented as required",
+				"documented `stale` not in schema",
+			},
+		},
+		{
+			name:     "force new",
+			forceNew: true,
+			doc: `## Argument Reference
+
+* ` + "`name`" + ` - (Required) Name.
+* ` + "`ttl`" + ` - (Optional, Forces new resource) TTL.
+    * ` + "`enabled`" + ` - (Optional) Whether TTL is enabled.
+
+## Attributes Reference
+
+* ` + "`arn`" + ` - ARN.
+* ` + "`replica`" + ` - Replicas.
+    * ` + "`region_name`" + ` - Region.
+`,
+			want: []string{
+				"argument `name` forces a new resource but is not documented as such",
+				"argument `ttl` is documented as forcing a new resource but can be updated in-place",
+			},
+		},
+		{
+			name: "force new not checked",
+			doc: `## Argument Reference
+
+* ` + "`name`" + ` - (Required) Name.
+* ` + "`ttl`" + ` - (Optional) TTL.
+    * ` + "`enabled`" + ` - (Optional) Whether TTL is enabled.
+
+## Attributes Reference
+
+* ` + "`arn`" + ` - ARN.
+* ` + "`replica`" + ` - Replicas.
+    * ` + "`region_name`" + ` - Region.
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			defer func(v bool) { *forceNew = v }(*forceNew)
+			*forceNew = testCase.forceNew
+
+			d, err := parseDoc("test.html.markdown", strings.NewReader(testCase.doc))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := checkDoc(schemaType{Name: "aws_test", Resource: resource}, d)
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(testCase.want, "\n"))
+			}
+		})
+	}
+}
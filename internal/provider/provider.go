@@ -446,9 +446,10 @@ func Provider() *schema.Provider {
 
 			"aws_cloudcontrolapi_resource": cloudcontrol.DataSourceResource(),
 
-			"aws_cloudformation_export": cloudformation.DataSourceExport(),
-			"aws_cloudformation_stack":  cloudformation.DataSourceStack(),
-			"aws_cloudformation_type":   cloudformation.DataSourceType(),
+			"aws_cloudformation_export":      cloudformation.DataSourceExport(),
+			"aws_cloudformation_stack":       cloudformation.DataSourceStack(),
+			"aws_cloudformation_stack_drift": cloudformation.DataSourceStackDrift(),
+			"aws_cloudformation_type":        cloudformation.DataSourceType(),
 
 			"aws_cloudfront_cache_policy":                   cloudfront.DataSourceCachePolicy(),
 			"aws_cloudfront_distribution":                   cloudfront.DataSourceDistribution(),
@@ -464,9 +465,12 @@ func Provider() *schema.Provider {
 
 			"aws_cloudtrail_service_account": cloudtrail.DataSourceServiceAccount(),
 
-			"aws_cloudwatch_event_bus":        events.DataSourceBus(),
-			"aws_cloudwatch_event_connection": events.DataSourceConnection(),
-			"aws_cloudwatch_event_source":     events.DataSourceSource(),
+			"aws_cloudwatch_dashboard_document": cloudwatch.DataSourceDashboardDocument(),
+
+			"aws_cloudwatch_event_bus":           events.DataSourceBus(),
+			"aws_cloudwatch_event_connection":    events.DataSourceConnection(),
+			"aws_cloudwatch_event_pattern_match": events.DataSourcePatternMatch(),
+			"aws_cloudwatch_event_source":        events.DataSourceSource(),
 
 			"aws_cloudwatch_log_group":  logs.DataSourceGroup(),
 			"aws_cloudwatch_log_groups": logs.DataSourceGroups(),
@@ -599,13 +603,14 @@ func Provider() *schema.Provider {
 			"aws_efs_file_system":   efs.DataSourceFileSystem(),
 			"aws_efs_mount_target":  efs.DataSourceMountTarget(),
 
-			"aws_eks_addon":         eks.DataSourceAddon(),
-			"aws_eks_addon_version": eks.DataSourceAddonVersion(),
-			"aws_eks_cluster":       eks.DataSourceCluster(),
-			"aws_eks_clusters":      eks.DataSourceClusters(),
-			"aws_eks_cluster_auth":  eks.DataSourceClusterAuth(),
-			"aws_eks_node_group":    eks.DataSourceNodeGroup(),
-			"aws_eks_node_groups":   eks.DataSourceNodeGroups(),
+			"aws_eks_addon":              eks.DataSourceAddon(),
+			"aws_eks_addon_version":      eks.DataSourceAddonVersion(),
+			"aws_eks_cluster":            eks.DataSourceCluster(),
+			"aws_eks_clusters":           eks.DataSourceClusters(),
+			"aws_eks_cluster_auth":       eks.DataSourceClusterAuth(),
+			"aws_eks_cluster_kubeconfig": eks.DataSourceClusterKubeconfig(),
+			"aws_eks_node_group":         eks.DataSourceNodeGroup(),
+			"aws_eks_node_groups":        eks.DataSourceNodeGroups(),
 
 			"aws_elasticache_cluster":           elasticache.DataSourceCluster(),
 			"aws_elasticache_replication_group": elasticache.DataSourceReplicationGroup(),
@@ -643,23 +648,26 @@ func Provider() *schema.Provider {
 
 			"aws_guardduty_detector": guardduty.DataSourceDetector(),
 
-			"aws_iam_account_alias":           iam.DataSourceAccountAlias(),
-			"aws_iam_group":                   iam.DataSourceGroup(),
-			"aws_iam_instance_profile":        iam.DataSourceInstanceProfile(),
-			"aws_iam_openid_connect_provider": iam.DataSourceOpenIDConnectProvider(),
-			"aws_iam_policy":                  iam.DataSourcePolicy(),
-			"aws_iam_policy_document":         iam.DataSourcePolicyDocument(),
-			"aws_iam_role":                    iam.DataSourceRole(),
-			"aws_iam_roles":                   iam.DataSourceRoles(),
-			"aws_iam_saml_provider":           iam.DataSourceSAMLProvider(),
-			"aws_iam_server_certificate":      iam.DataSourceServerCertificate(),
-			"aws_iam_session_context":         iam.DataSourceSessionContext(),
-			"aws_iam_user":                    iam.DataSourceUser(),
-			"aws_iam_user_ssh_key":            iam.DataSourceUserSSHKey(),
-			"aws_iam_users":                   iam.DataSourceUsers(),
-
-			"aws_identitystore_group": identitystore.DataSourceGroup(),
-			"aws_identitystore_user":  identitystore.DataSourceUser(),
+			"aws_iam_account_alias":               iam.DataSourceAccountAlias(),
+			"aws_iam_group":                       iam.DataSourceGroup(),
+			"aws_iam_instance_profile":            iam.DataSourceInstanceProfile(),
+			"aws_iam_openid_connect_provider":     iam.DataSourceOpenIDConnectProvider(),
+			"aws_iam_policy":                      iam.DataSourcePolicy(),
+			"aws_iam_policy_document":             iam.DataSourcePolicyDocument(),
+			"aws_iam_principal_policy_simulation": iam.DataSourcePrincipalPolicySimulation(),
+			"aws_iam_role":                        iam.DataSourceRole(),
+			"aws_iam_roles":                       iam.DataSourceRoles(),
+			"aws_iam_saml_provider":               iam.DataSourceSAMLProvider(),
+			"aws_iam_server_certificate":          iam.DataSourceServerCertificate(),
+			"aws_iam_session_context":             iam.DataSourceSessionContext(),
+			"aws_iam_user":                        iam.DataSourceUser(),
+			"aws_iam_user_ssh_key":                iam.DataSourceUserSSHKey(),
+			"aws_iam_users":                       iam.DataSourceUsers(),
+
+			"aws_identitystore_group":  identitystore.DataSourceGroup(),
+			"aws_identitystore_groups": identitystore.DataSourceGroups(),
+			"aws_identitystore_user":   identitystore.DataSourceUser(),
+			"aws_identitystore_users":  identitystore.DataSourceUsers(),
 
 			"aws_imagebuilder_component":                     imagebuilder.DataSourceComponent(),
 			"aws_imagebuilder_components":                    imagebuilder.DataSourceComponents(),
@@ -691,12 +699,13 @@ func Provider() *schema.Provider {
 			"aws_kinesis_stream":          kinesis.DataSourceStream(),
 			"aws_kinesis_stream_consumer": kinesis.DataSourceStreamConsumer(),
 
-			"aws_kms_alias":      kms.DataSourceAlias(),
-			"aws_kms_ciphertext": kms.DataSourceCiphertext(),
-			"aws_kms_key":        kms.DataSourceKey(),
-			"aws_kms_public_key": kms.DataSourcePublicKey(),
-			"aws_kms_secret":     kms.DataSourceSecret(),
-			"aws_kms_secrets":    kms.DataSourceSecrets(),
+			"aws_kms_alias":               kms.DataSourceAlias(),
+			"aws_kms_ciphertext":          kms.DataSourceCiphertext(),
+			"aws_kms_key":                 kms.DataSourceKey(),
+			"aws_kms_key_policy_document": kms.DataSourceKeyPolicyDocument(),
+			"aws_kms_public_key":          kms.DataSourcePublicKey(),
+			"aws_kms_secret":              kms.DataSourceSecret(),
+			"aws_kms_secrets":             kms.DataSourceSecrets(),
 
 			"aws_lakeformation_data_lake_settings": lakeformation.DataSourceDataLakeSettings(),
 			"aws_lakeformation_permissions":        lakeformation.DataSourcePermissions(),
@@ -771,16 +780,17 @@ func Provider() *schema.Provider {
 			"aws_ses_domain_identity":         ses.DataSourceDomainIdentity(),
 			"aws_ses_email_identity":          ses.DataSourceEmailIdentity(),
 
-			"aws_db_cluster_snapshot":       rds.DataSourceClusterSnapshot(),
-			"aws_db_event_categories":       rds.DataSourceEventCategories(),
-			"aws_db_instance":               rds.DataSourceInstance(),
-			"aws_db_proxy":                  rds.DataSourceProxy(),
-			"aws_db_snapshot":               rds.DataSourceSnapshot(),
-			"aws_db_subnet_group":           rds.DataSourceSubnetGroup(),
-			"aws_rds_certificate":           rds.DataSourceCertificate(),
-			"aws_rds_cluster":               rds.DataSourceCluster(),
-			"aws_rds_engine_version":        rds.DataSourceEngineVersion(),
-			"aws_rds_orderable_db_instance": rds.DataSourceOrderableInstance(),
+			"aws_db_cluster_snapshot":            rds.DataSourceClusterSnapshot(),
+			"aws_db_event_categories":            rds.DataSourceEventCategories(),
+			"aws_db_instance":                    rds.DataSourceInstance(),
+			"aws_db_proxy":                       rds.DataSourceProxy(),
+			"aws_db_snapshot":                    rds.DataSourceSnapshot(),
+			"aws_db_subnet_group":                rds.DataSourceSubnetGroup(),
+			"aws_rds_certificate":                rds.DataSourceCertificate(),
+			"aws_rds_cluster":                    rds.DataSourceCluster(),
+			"aws_rds_engine_version":             rds.DataSourceEngineVersion(),
+			"aws_rds_orderable_db_instance":      rds.DataSourceOrderableInstance(),
+			"aws_rds_reserved_instance_offering": rds.DataSourceReservedInstanceOffering(),
 
 			"aws_redshift_cluster":           redshift.DataSourceCluster(),
 			"aws_redshift_orderable_cluster": redshift.DataSourceOrderableCluster(),
@@ -823,8 +833,9 @@ func Provider() *schema.Provider {
 			"aws_servicequotas_service":       servicequotas.DataSourceService(),
 			"aws_servicequotas_service_quota": servicequotas.DataSourceServiceQuota(),
 
-			"aws_sfn_activity":      sfn.DataSourceActivity(),
-			"aws_sfn_state_machine": sfn.DataSourceStateMachine(),
+			"aws_sfn_activity":                          sfn.DataSourceActivity(),
+			"aws_sfn_state_machine":                     sfn.DataSourceStateMachine(),
+			"aws_sfn_state_machine_definition_document": sfn.DataSourceStateMachineDefinitionDocument(),
 
 			"aws_signer_signing_job":     signer.DataSourceSigningJob(),
 			"aws_signer_signing_profile": signer.DataSourceSigningProfile(),
@@ -1030,6 +1041,7 @@ func Provider() *schema.Provider {
 			"aws_cloudfront_field_level_encryption_config":  cloudfront.ResourceFieldLevelEncryptionConfig(),
 			"aws_cloudfront_field_level_encryption_profile": cloudfront.ResourceFieldLevelEncryptionProfile(),
 			"aws_cloudfront_function":                       cloudfront.ResourceFunction(),
+			"aws_cloudfront_invalidation":                   cloudfront.ResourceInvalidation(),
 			"aws_cloudfront_key_group":                      cloudfront.ResourceKeyGroup(),
 			"aws_cloudfront_monitoring_subscription":        cloudfront.ResourceMonitoringSubscription(),
 			"aws_cloudfront_origin_access_identity":         cloudfront.ResourceOriginAccessIdentity(),
@@ -1214,7 +1226,9 @@ func Provider() *schema.Provider {
 			"aws_dynamodb_global_table":                  dynamodb.ResourceGlobalTable(),
 			"aws_dynamodb_kinesis_streaming_destination": dynamodb.ResourceKinesisStreamingDestination(),
 			"aws_dynamodb_table":                         dynamodb.ResourceTable(),
+			"aws_dynamodb_table_export":                  dynamodb.ResourceTableExport(),
 			"aws_dynamodb_table_item":                    dynamodb.ResourceTableItem(),
+			"aws_dynamodb_table_items":                   dynamodb.ResourceTableItems(),
 			"aws_dynamodb_tag":                           dynamodb.ResourceTag(),
 
 			"aws_ami":                                              ec2.ResourceAMI(),
@@ -1506,6 +1520,10 @@ func Provider() *schema.Provider {
 			"aws_iam_user_ssh_key":                iam.ResourceUserSSHKey(),
 			"aws_iam_virtual_mfa_device":          iam.ResourceVirtualMFADevice(),
 
+			"aws_identitystore_group":            identitystore.ResourceGroup(),
+			"aws_identitystore_group_membership": identitystore.ResourceGroupMembership(),
+			"aws_identitystore_user":             identitystore.ResourceUser(),
+
 			"aws_imagebuilder_component":                    imagebuilder.ResourceComponent(),
 			"aws_imagebuilder_container_recipe":             imagebuilder.ResourceContainerRecipe(),
 			"aws_imagebuilder_distribution_configuration":   imagebuilder.ResourceDistributionConfiguration(),
@@ -1554,6 +1572,7 @@ func Provider() *schema.Provider {
 
 			"aws_kms_alias":                kms.ResourceAlias(),
 			"aws_kms_ciphertext":           kms.ResourceCiphertext(),
+			"aws_kms_custom_key_store":     kms.ResourceCustomKeyStore(),
 			"aws_kms_external_key":         kms.ResourceExternalKey(),
 			"aws_kms_grant":                kms.ResourceGrant(),
 			"aws_kms_key":                  kms.ResourceKey(),
@@ -1721,6 +1740,7 @@ func Provider() *schema.Provider {
 			"aws_rds_cluster_parameter_group":               rds.ResourceClusterParameterGroup(),
 			"aws_rds_cluster_role_association":              rds.ResourceClusterRoleAssociation(),
 			"aws_rds_global_cluster":                        rds.ResourceGlobalCluster(),
+			"aws_rds_reserved_instance":                     rds.ResourceReservedInstance(),
 
 			"aws_redshift_cluster":                       redshift.ResourceCluster(),
 			"aws_redshift_event_subscription":            redshift.ResourceEventSubscription(),
@@ -1924,10 +1944,12 @@ func Provider() *schema.Provider {
 			"aws_ssm_patch_group":               ssm.ResourcePatchGroup(),
 			"aws_ssm_resource_data_sync":        ssm.ResourceResourceDataSync(),
 
-			"aws_ssoadmin_account_assignment":           ssoadmin.ResourceAccountAssignment(),
-			"aws_ssoadmin_managed_policy_attachment":    ssoadmin.ResourceManagedPolicyAttachment(),
-			"aws_ssoadmin_permission_set":               ssoadmin.ResourcePermissionSet(),
-			"aws_ssoadmin_permission_set_inline_policy": ssoadmin.ResourcePermissionSetInlinePolicy(),
+			"aws_ssoadmin_account_assignment":                 ssoadmin.ResourceAccountAssignment(),
+			"aws_ssoadmin_customer_managed_policy_attachment": ssoadmin.ResourceCustomerManagedPolicyAttachment(),
+			"aws_ssoadmin_managed_policy_attachment":          ssoadmin.ResourceManagedPolicyAttachment(),
+			"aws_ssoadmin_permission_set":                     ssoadmin.ResourcePermissionSet(),
+			"aws_ssoadmin_permission_set_inline_policy":       ssoadmin.ResourcePermissionSetInlinePolicy(),
+			"aws_ssoadmin_permissions_boundary_attachment":    ssoadmin.ResourcePermissionsBoundaryAttachment(),
 
 			"aws_storagegateway_cache":                   storagegateway.ResourceCache(),
 			"aws_storagegateway_cached_iscsi_volume":     storagegateway.ResourceCachediSCSIVolume(),
@@ -12,11 +12,12 @@ const (
 	ErrCodeValidationError = "ValidationError"
 )
 
+// StackSetOperationError returns an error describing each stack instance that the operation didn't succeed for.
 func StackSetOperationError(apiObjects []*cloudformation.StackSetOperationResultSummary) error {
 	var errors *multierror.Error
 
 	for _, apiObject := range apiObjects {
-		if apiObject == nil {
+		if apiObject == nil || aws.StringValue(apiObject.Status) == cloudformation.StackSetOperationResultStatusSucceeded {
 			continue
 		}
 
@@ -30,3 +31,21 @@ func StackSetOperationError(apiObjects []*cloudformation.StackSetOperationResult
 
 	return errors.ErrorOrNil()
 }
+
+func StackDriftError(apiObjects []*cloudformation.StackResourceDrift) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		errors = multierror.Append(errors, fmt.Errorf("Resource (%s) Type (%s) Status (%s)",
+			aws.StringValue(apiObject.LogicalResourceId),
+			aws.StringValue(apiObject.ResourceType),
+			aws.StringValue(apiObject.StackResourceDriftStatus),
+		))
+	}
+
+	return errors.ErrorOrNil()
+}
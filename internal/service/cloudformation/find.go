@@ -212,6 +212,46 @@ func FindStackSetOperationByStackSetNameAndOperationID(conn *cloudformation.Clou
 	return output.StackSetOperation, nil
 }
 
+func FindStackSetOperationResultsByStackSetNameAndOperationID(conn *cloudformation.CloudFormation, stackSetName, operationID, callAs string) ([]*cloudformation.StackSetOperationResultSummary, error) {
+	input := &cloudformation.ListStackSetOperationResultsInput{
+		OperationId:  aws.String(operationID),
+		StackSetName: aws.String(stackSetName),
+	}
+
+	if callAs != "" {
+		input.CallAs = aws.String(callAs)
+	}
+
+	var output []*cloudformation.StackSetOperationResultSummary
+
+	err := conn.ListStackSetOperationResultsPages(input, func(page *cloudformation.ListStackSetOperationResultsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Summaries {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeStackSetNotFoundException) || tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeOperationNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func FindTypeByARN(ctx context.Context, conn *cloudformation.CloudFormation, arn string) (*cloudformation.DescribeTypeOutput, error) {
 	input := &cloudformation.DescribeTypeInput{
 		Arn: aws.String(arn),
@@ -281,3 +321,67 @@ func FindTypeRegistrationByToken(ctx context.Context, conn *cloudformation.Cloud
 
 	return output, nil
 }
+
+func FindStackDriftDetectionStatusByID(conn *cloudformation.CloudFormation, id string) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	input := &cloudformation.DescribeStackDriftDetectionStatusInput{
+		StackDriftDetectionId: aws.String(id),
+	}
+
+	output, err := conn.DescribeStackDriftDetectionStatus(input)
+
+	if tfawserr.ErrMessageContains(err, ErrCodeValidationError, "does not exist") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindStackResourceDriftsByStackName(conn *cloudformation.CloudFormation, stackName string, statuses []*string) ([]*cloudformation.StackResourceDrift, error) {
+	input := &cloudformation.DescribeStackResourceDriftsInput{
+		StackName: aws.String(stackName),
+	}
+
+	if len(statuses) > 0 {
+		input.StackResourceDriftStatusFilters = statuses
+	}
+
+	var output []*cloudformation.StackResourceDrift
+
+	err := conn.DescribeStackResourceDriftsPages(input, func(page *cloudformation.DescribeStackResourceDriftsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.StackResourceDrifts {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrMessageContains(err, ErrCodeValidationError, "does not exist") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
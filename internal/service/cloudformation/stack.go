@@ -3,6 +3,7 @@ package cloudformation
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -70,6 +71,11 @@ func ResourceStack() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"fail_on_drift": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"notification_arns": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -320,6 +326,18 @@ func resourceStackUpdate(d *schema.ResourceData, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
+	// fail_on_drift only affects how the stack is updated.
+	if !d.HasChangesExcept("fail_on_drift") {
+		return resourceStackRead(d, meta)
+	}
+
+	// Updating a drifted stack can fail or silently overwrite changes made outside of CloudFormation.
+	if d.Get("fail_on_drift").(bool) {
+		if err := checkStackDrift(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
 	requestToken := resource.UniqueId()
 	input := &cloudformation.UpdateStackInput{
 		StackName:          aws.String(d.Id()),
@@ -398,6 +416,26 @@ func resourceStackUpdate(d *schema.ResourceData, meta interface{}) error {
 	return resourceStackRead(d, meta)
 }
 
+func checkStackDrift(conn *cloudformation.CloudFormation, stackID string, timeout time.Duration) error {
+	detection, err := detectStackDrift(conn, stackID, timeout)
+
+	if err != nil {
+		return err
+	}
+
+	if aws.StringValue(detection.StackDriftStatus) != cloudformation.StackDriftStatusDrifted {
+		return nil
+	}
+
+	drifts, err := FindStackResourceDriftsByStackName(conn, stackID, aws.StringSlice([]string{cloudformation.StackResourceDriftStatusModified, cloudformation.StackResourceDriftStatusDeleted}))
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFormation Stack (%s) resource drifts: %w", stackID, err)
+	}
+
+	return fmt.Errorf("CloudFormation Stack (%s) has drifted from its template, resolve the drift or set fail_on_drift to false before updating: %w", stackID, StackDriftError(drifts))
+}
+
 func resourceStackDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CloudFormationConn
 
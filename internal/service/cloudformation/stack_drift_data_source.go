@@ -0,0 +1,220 @@
+package cloudformation
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceStackDrift() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceStackDriftRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(StackDriftDetectedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"detection_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"drift_detection_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"drifted_stack_resource_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource_drift_statuses": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(cloudformation.StackResourceDriftStatus_Values(), false),
+				},
+			},
+			"resource_drifts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actual_properties": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expected_properties": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"logical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"property_differences": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"actual_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"difference_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"expected_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"property_path": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stack_resource_drift_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"stack_drift_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stack_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceStackDriftRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFormationConn
+
+	name := d.Get("name").(string)
+	detection, err := detectStackDrift(conn, name, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return err
+	}
+
+	drifts, err := FindStackResourceDriftsByStackName(conn, name, flex.ExpandStringSet(d.Get("resource_drift_statuses").(*schema.Set)))
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFormation Stack (%s) resource drifts: %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(detection.StackId))
+	d.Set("detection_id", detection.StackDriftDetectionId)
+	d.Set("drift_detection_time", aws.TimeValue(detection.Timestamp).Format(time.RFC3339))
+	d.Set("drifted_stack_resource_count", detection.DriftedStackResourceCount)
+
+	if err := d.Set("resource_drifts", flattenStackResourceDrifts(drifts)); err != nil {
+		return fmt.Errorf("error setting resource_drifts: %w", err)
+	}
+
+	d.Set("stack_drift_status", detection.StackDriftStatus)
+	d.Set("stack_id", detection.StackId)
+
+	return nil
+}
+
+// detectStackDrift starts drift detection on a stack and waits for it to complete.
+func detectStackDrift(conn *cloudformation.CloudFormation, stackName string, timeout time.Duration) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	input := &cloudformation.DetectStackDriftInput{
+		StackName: aws.String(stackName),
+	}
+
+	log.Printf("[DEBUG] Detecting CloudFormation Stack drift: %s", input)
+	output, err := conn.DetectStackDrift(input)
+
+	if err != nil {
+		return nil, fmt.Errorf("error detecting CloudFormation Stack (%s) drift: %w", stackName, err)
+	}
+
+	detectionID := aws.StringValue(output.StackDriftDetectionId)
+	detection, err := WaitStackDriftDetected(conn, detectionID, timeout)
+
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for CloudFormation Stack (%s) drift detection (%s): %w", stackName, detectionID, err)
+	}
+
+	return detection, nil
+}
+
+func flattenStackResourceDrifts(apiObjects []*cloudformation.StackResourceDrift) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"actual_properties":           aws.StringValue(apiObject.ActualProperties),
+			"expected_properties":         aws.StringValue(apiObject.ExpectedProperties),
+			"logical_resource_id":         aws.StringValue(apiObject.LogicalResourceId),
+			"physical_resource_id":        aws.StringValue(apiObject.PhysicalResourceId),
+			"property_differences":        flattenPropertyDifferences(apiObject.PropertyDifferences),
+			"resource_type":               aws.StringValue(apiObject.ResourceType),
+			"stack_resource_drift_status": aws.StringValue(apiObject.StackResourceDriftStatus),
+		}
+
+		if v := apiObject.Timestamp; v != nil {
+			tfMap["timestamp"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenPropertyDifferences(apiObjects []*cloudformation.PropertyDifference) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"actual_value":    aws.StringValue(apiObject.ActualValue),
+			"difference_type": aws.StringValue(apiObject.DifferenceType),
+			"expected_value":  aws.StringValue(apiObject.ExpectedValue),
+			"property_path":   aws.StringValue(apiObject.PropertyPath),
+		})
+	}
+
+	return tfList
+}
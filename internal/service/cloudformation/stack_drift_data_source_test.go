@@ -0,0 +1,119 @@
+package cloudformation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudFormationStackDriftDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudformation_stack_drift.test"
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackDriftDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "stack_id", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "detection_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "drift_detection_time"),
+					resource.TestCheckResourceAttr(dataSourceName, "drifted_stack_resource_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "stack_drift_status", cloudformation.StackDriftStatusInSync),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_drifts.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_drifts.0.logical_resource_id", "Parameter"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_drifts.0.physical_resource_id", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_drifts.0.resource_type", "AWS::SSM::Parameter"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_drifts.0.stack_resource_drift_status", cloudformation.StackResourceDriftStatusInSync),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_drifts.0.property_differences.#", "0"),
+				),
+			},
+			{
+				PreConfig: func() { testAccStackDriftSSMParameter(t, rName) },
+				Config:    testAccStackDriftDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "drifted_stack_resource_count", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "stack_drift_status", cloudformation.StackDriftStatusDrifted),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_drifts.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_drifts.0.stack_resource_drift_status", cloudformation.StackResourceDriftStatusModified),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_drifts.0.property_differences.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_drifts.0.property_differences.0.property_path", "/Value"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_drifts.0.property_differences.0.expected_value", "original"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_drifts.0.property_differences.0.actual_value", "drifted"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_drifts.0.property_differences.0.difference_type", cloudformation.DifferenceTypeNotEqual),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFormationStackDriftDataSource_resourceDriftStatuses(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudformation_stack_drift.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackDriftDataSourceConfig_resourceDriftStatuses(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "stack_drift_status", cloudformation.StackDriftStatusInSync),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_drifts.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStackDriftDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name = %[1]q
+
+  parameters = {
+    Value = "original"
+  }
+
+  template_body = <<STACK
+%[2]s
+STACK
+}
+
+data "aws_cloudformation_stack_drift" "test" {
+  name = aws_cloudformation_stack.test.id
+}
+`, rName, testAccStackConfig_ssmParameterTemplate(rName))
+}
+
+func testAccStackDriftDataSourceConfig_resourceDriftStatuses(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name = %[1]q
+
+  parameters = {
+    Value = "original"
+  }
+
+  template_body = <<STACK
+%[2]s
+STACK
+}
+
+data "aws_cloudformation_stack_drift" "test" {
+  name                    = aws_cloudformation_stack.test.id
+  resource_drift_statuses = ["MODIFIED", "DELETED"]
+}
+`, rName, testAccStackConfig_ssmParameterTemplate(rName))
+}
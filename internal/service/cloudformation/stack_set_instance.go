@@ -57,6 +57,23 @@ func ResourceStackSetInstance() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"account_filter_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(cloudformation.AccountFilterType_Values(), false),
+						},
+						"accounts": {
+							Type:          schema.TypeSet,
+							Optional:      true,
+							Elem:          &schema.Schema{Type: schema.TypeString, ValidateFunc: verify.ValidAccountID},
+							ConflictsWith: []string{"deployment_targets.0.accounts_url"},
+						},
+						"accounts_url": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ValidateFunc:  validation.StringMatch(regexp.MustCompile(`^https://.+\.(csv|txt)$`), "must be an HTTPS URL of a .csv or .txt file"),
+							ConflictsWith: []string{"deployment_targets.0.accounts"},
+						},
 						"organizational_unit_ids": {
 							Type:     schema.TypeSet,
 							Optional: true,
@@ -177,9 +194,18 @@ func resourceStackSetInstanceCreate(d *schema.ResourceData, meta interface{}) er
 
 	if v, ok := d.GetOk("deployment_targets"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		dt := expandCloudFormationDeploymentTargets(v.([]interface{}))
-		// temporarily set the accountId to the DeploymentTarget IDs
-		// to later inform the Read CRUD operation if the true accountID needs to be determined
-		accountID = strings.Join(aws.StringValueSlice(dt.OrganizationalUnitIds), "/")
+
+		switch {
+		case len(dt.OrganizationalUnitIds) > 0:
+			// temporarily set the accountId to the DeploymentTarget IDs
+			// to later inform the Read CRUD operation if the true accountID needs to be determined
+			accountID = strings.Join(aws.StringValueSlice(dt.OrganizationalUnitIds), "/")
+		case len(dt.Accounts) == 1:
+			accountID = aws.StringValue(dt.Accounts[0])
+		default:
+			return fmt.Errorf("error creating CloudFormation StackSet (%s) Instance: deployment_targets without organizational_unit_ids must target a single account", stackSetName)
+		}
+
 		input.DeploymentTargets = dt
 	} else {
 		input.Accounts = aws.StringSlice([]string{accountID})
@@ -419,6 +445,18 @@ func expandCloudFormationDeploymentTargets(l []interface{}) *cloudformation.Depl
 
 	dt := &cloudformation.DeploymentTargets{}
 
+	if v, ok := tfMap["account_filter_type"].(string); ok && v != "" {
+		dt.AccountFilterType = aws.String(v)
+	}
+
+	if v, ok := tfMap["accounts"].(*schema.Set); ok && v.Len() > 0 {
+		dt.Accounts = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["accounts_url"].(string); ok && v != "" {
+		dt.AccountsUrl = aws.String(v)
+	}
+
 	if v, ok := tfMap["organizational_unit_ids"].(*schema.Set); ok && v.Len() > 0 {
 		dt.OrganizationalUnitIds = flex.ExpandStringSet(v)
 	}
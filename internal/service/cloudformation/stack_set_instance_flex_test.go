@@ -0,0 +1,90 @@
+package cloudformation
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandCloudFormationDeploymentTargets(t *testing.T) {
+	tfList := []interface{}{
+		map[string]interface{}{
+			"account_filter_type":     cloudformation.AccountFilterTypeIntersection,
+			"accounts":                schema.NewSet(schema.HashString, []interface{}{"123456789012"}),
+			"accounts_url":            "",
+			"organizational_unit_ids": schema.NewSet(schema.HashString, []interface{}{"ou-abcd-12345678"}),
+		},
+	}
+
+	expected := &cloudformation.DeploymentTargets{
+		AccountFilterType:     aws.String(cloudformation.AccountFilterTypeIntersection),
+		Accounts:              aws.StringSlice([]string{"123456789012"}),
+		OrganizationalUnitIds: aws.StringSlice([]string{"ou-abcd-12345678"}),
+	}
+
+	if got := expandCloudFormationDeploymentTargets(tfList); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	if got := expandCloudFormationDeploymentTargets(nil); got != nil {
+		t.Errorf("expected nil, got %v", got)
+	}
+}
+
+func TestExpandCloudFormationOperationPreferences(t *testing.T) {
+	tfMap := map[string]interface{}{
+		"failure_tolerance_count":      0,
+		"failure_tolerance_percentage": 10,
+		"max_concurrent_count":         2,
+		"max_concurrent_percentage":    0,
+		"region_concurrency_type":      cloudformation.RegionConcurrencyTypeSequential,
+		"region_order":                 []interface{}{"us-west-2", "us-east-1"},
+	}
+
+	expected := &cloudformation.StackSetOperationPreferences{
+		FailureTolerancePercentage: aws.Int64(10),
+		MaxConcurrentCount:         aws.Int64(2),
+		RegionConcurrencyType:      aws.String(cloudformation.RegionConcurrencyTypeSequential),
+		// Region order is significant.
+		RegionOrder: aws.StringSlice([]string{"us-west-2", "us-east-1"}),
+	}
+
+	if got := expandCloudFormationOperationPreferences(tfMap); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestStackSetOperationError(t *testing.T) {
+	summaries := []*cloudformation.StackSetOperationResultSummary{
+		{
+			Account: aws.String("111111111111"),
+			Region:  aws.String("us-west-2"),
+			Status:  aws.String(cloudformation.StackSetOperationResultStatusSucceeded),
+		},
+		{
+			Account:      aws.String("222222222222"),
+			Region:       aws.String("us-west-2"),
+			Status:       aws.String(cloudformation.StackSetOperationResultStatusFailed),
+			StatusReason: aws.String("Account 222222222222 should have 'AWSCloudFormationStackSetExecutionRole' role"),
+		},
+	}
+
+	err := StackSetOperationError(summaries)
+
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	expected := "1 error occurred:\n\t* Account (222222222222) Region (us-west-2) Status (FAILED) Status Reason: Account 222222222222 should have 'AWSCloudFormationStackSetExecutionRole' role\n\n"
+
+	if err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err)
+	}
+
+	if err := StackSetOperationError(summaries[:1]); err != nil {
+		t.Errorf("expected no error for successful results, got %s", err)
+	}
+}
@@ -269,6 +269,38 @@ func TestAccCloudFormationStackSetInstance_deploymentTargets(t *testing.T) {
 	})
 }
 
+func TestAccCloudFormationStackSetInstance_DeploymentTargets_accountFilter(t *testing.T) {
+	var stackInstance cloudformation.StackInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckStackSet(t)
+			acctest.PreCheckOrganizationsEnabled(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+			acctest.PreCheckIAMServiceLinkedRole(t, "/aws-service-role/stacksets.cloudformation.amazonaws.com")
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, cloudformation.EndpointsID, "organizations"),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckStackSetInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackSetInstanceDeploymentTargetsAccountFilterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetInstanceExists(resourceName, &stackInstance),
+					resource.TestCheckResourceAttr(resourceName, "deployment_targets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_targets.0.account_filter_type", cloudformation.AccountFilterTypeDifference),
+					resource.TestCheckResourceAttr(resourceName, "deployment_targets.0.accounts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.region_order.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.region_concurrency_type", cloudformation.RegionConcurrencyTypeSequential),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudFormationStackSetInstance_operationPreferences(t *testing.T) {
 	var stackInstance cloudformation.StackInstance
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -705,6 +737,30 @@ resource "aws_cloudformation_stack_set_instance" "test" {
 `)
 }
 
+func testAccStackSetInstanceDeploymentTargetsAccountFilterConfig(rName string) string {
+	return acctest.ConfigCompose(testAccStackSetInstanceBaseConfig_ServiceManagedStackSet(rName), `
+data "aws_region" "current" {}
+
+resource "aws_cloudformation_stack_set_instance" "test" {
+  depends_on = [aws_iam_role_policy.Administration, aws_iam_role_policy.Execution]
+
+  deployment_targets {
+    account_filter_type     = "DIFFERENCE"
+    accounts                = ["123456789012"]
+    organizational_unit_ids = [data.aws_organizations_organization.test.roots[0].id]
+  }
+
+  operation_preferences {
+    failure_tolerance_count = 1
+    region_concurrency_type = "SEQUENTIAL"
+    region_order            = [data.aws_region.current.name]
+  }
+
+  stack_set_name = aws_cloudformation_stack_set.test.name
+}
+`)
+}
+
 func testAccStackSetInstanceConfig_ServiceManagedStackSet(rName string) string {
 	return acctest.ConfigCompose(testAccStackSetInstanceBaseConfig_ServiceManagedStackSet(rName), `
 resource "aws_cloudformation_stack_set_instance" "test" {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -444,6 +445,63 @@ func TestAccCloudFormationStack_onFailure(t *testing.T) {
 	})
 }
 
+func TestAccCloudFormationStack_failOnDrift(t *testing.T) {
+	var stack1, stack2 cloudformation.Stack
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_failOnDrift(rName, "original"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackExists(resourceName, &stack1),
+					resource.TestCheckResourceAttr(resourceName, "fail_on_drift", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"fail_on_drift"},
+			},
+			{
+				PreConfig:   func() { testAccStackDriftSSMParameter(t, rName) },
+				Config:      testAccStackConfig_failOnDrift(rName, "updated"),
+				ExpectError: regexp.MustCompile(`(?s)has drifted from its template.*Resource \(Parameter\) Type \(AWS::SSM::Parameter\) Status \(MODIFIED\)`),
+			},
+			{
+				Config: testAccStackConfig_failOnDriftDisabled(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackExists(resourceName, &stack2),
+					resource.TestCheckResourceAttr(resourceName, "fail_on_drift", "false"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.Value", "updated"),
+				),
+			},
+		},
+	})
+}
+
+// testAccStackDriftSSMParameter changes the value of an SSM parameter outside of CloudFormation.
+func testAccStackDriftSSMParameter(t *testing.T, name string) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+
+	_, err := conn.PutParameter(&ssm.PutParameterInput{
+		Name:      aws.String(name),
+		Overwrite: aws.Bool(true),
+		Type:      aws.String(ssm.ParameterTypeString),
+		Value:     aws.String("drifted"),
+	})
+
+	if err != nil {
+		t.Fatalf("error updating SSM Parameter (%s): %s", name, err)
+	}
+}
+
 func testAccCheckCloudFormationStackExists(n string, stack *cloudformation.Stack) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1033,3 +1091,59 @@ resource "aws_cloudformation_stack" "test" {
 }
 `, rName)
 }
+
+func testAccStackConfig_ssmParameterTemplate(rName string) string {
+	return fmt.Sprintf(`
+{
+  "Parameters": {
+    "Value": {
+      "Type": "String"
+    }
+  },
+  "Resources": {
+    "Parameter": {
+      "Type": "AWS::SSM::Parameter",
+      "Properties": {
+        "Name": %[1]q,
+        "Type": "String",
+        "Value": { "Ref": "Value" }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccStackConfig_failOnDrift(rName, value string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name          = %[1]q
+  fail_on_drift = true
+
+  parameters = {
+    Value = %[2]q
+  }
+
+  template_body = <<STACK
+%[3]s
+STACK
+}
+`, rName, value, testAccStackConfig_ssmParameterTemplate(rName))
+}
+
+func testAccStackConfig_failOnDriftDisabled(rName, value string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name          = %[1]q
+  fail_on_drift = false
+
+  parameters = {
+    Value = %[2]q
+  }
+
+  template_body = <<STACK
+%[3]s
+STACK
+}
+`, rName, value, testAccStackConfig_ssmParameterTemplate(rName))
+}
@@ -63,6 +63,22 @@ func StatusStack(conn *cloudformation.CloudFormation, stackName string) resource
 	}
 }
 
+func StatusStackDriftDetection(conn *cloudformation.CloudFormation, detectionID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindStackDriftDetectionStatusByID(conn, detectionID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DetectionStatus), nil
+	}
+}
+
 func StatusTypeRegistrationProgress(ctx context.Context, conn *cloudformation.CloudFormation, registrationToken string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindTypeRegistrationByToken(ctx, conn, registrationToken)
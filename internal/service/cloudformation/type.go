@@ -335,8 +335,8 @@ func expandCloudFormationOperationPreferences(tfMap map[string]interface{}) *clo
 	if v, ok := tfMap["region_concurrency_type"].(string); ok && v != "" {
 		apiObject.RegionConcurrencyType = aws.String(v)
 	}
-	if v, ok := tfMap["region_order"].(*schema.Set); ok && v.Len() > 0 {
-		apiObject.RegionOrder = flex.ExpandStringSet(v)
+	if v, ok := tfMap["region_order"].([]interface{}); ok && len(v) > 0 {
+		apiObject.RegionOrder = flex.ExpandStringList(v)
 	}
 
 	if ftc, ftp := aws.Int64Value(apiObject.FailureToleranceCount), aws.Int64Value(apiObject.FailureTolerancePercentage); ftp == 0 {
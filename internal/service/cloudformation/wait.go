@@ -58,7 +58,7 @@ const (
 
 func WaitStackSetOperationSucceeded(conn *cloudformation.CloudFormation, stackSetName, operationID, callAs string, timeout time.Duration) (*cloudformation.StackSetOperation, error) {
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{cloudformation.StackSetOperationStatusRunning, cloudformation.StackSetOperationStatusQueued},
+		Pending: []string{cloudformation.StackSetOperationStatusRunning, cloudformation.StackSetOperationStatusQueued, cloudformation.StackSetOperationStatusStopping},
 		Target:  []string{cloudformation.StackSetOperationStatusSucceeded},
 		Refresh: StatusStackSetOperation(conn, stackSetName, operationID, callAs),
 		Timeout: timeout,
@@ -68,28 +68,13 @@ func WaitStackSetOperationSucceeded(conn *cloudformation.CloudFormation, stackSe
 	outputRaw, waitErr := stateConf.WaitForState()
 
 	if output, ok := outputRaw.(*cloudformation.StackSetOperation); ok {
-		if status := aws.StringValue(output.Status); status == cloudformation.StackSetOperationStatusFailed {
-			input := &cloudformation.ListStackSetOperationResultsInput{
-				OperationId:  aws.String(operationID),
-				StackSetName: aws.String(stackSetName),
-				CallAs:       aws.String(callAs),
-			}
-			var summaries []*cloudformation.StackSetOperationResultSummary
-
-			listErr := conn.ListStackSetOperationResultsPages(input, func(page *cloudformation.ListStackSetOperationResultsOutput, lastPage bool) bool {
-				if page == nil {
-					return !lastPage
-				}
-
-				summaries = append(summaries, page.Summaries...)
+		if status := aws.StringValue(output.Status); status == cloudformation.StackSetOperationStatusFailed || status == cloudformation.StackSetOperationStatusStopped {
+			summaries, listErr := FindStackSetOperationResultsByStackSetNameAndOperationID(conn, stackSetName, operationID, callAs)
 
-				return !lastPage
-			})
-
-			if listErr == nil {
-				tfresource.SetLastError(waitErr, fmt.Errorf("Operation (%s) Results: %w", operationID, StackSetOperationError(summaries)))
-			} else {
+			if listErr != nil {
 				tfresource.SetLastError(waitErr, fmt.Errorf("error listing CloudFormation Stack Set (%s) Operation (%s) results: %w", stackSetName, operationID, listErr))
+			} else if err := StackSetOperationError(summaries); err != nil {
+				tfresource.SetLastError(waitErr, fmt.Errorf("Operation (%s) Results: %w", operationID, err))
 			}
 		}
 
@@ -99,6 +84,35 @@ func WaitStackSetOperationSucceeded(conn *cloudformation.CloudFormation, stackSe
 	return nil, waitErr
 }
 
+const (
+	// Maximum amount of time to wait for Stack drift detection to complete
+	StackDriftDetectedTimeout = 15 * time.Minute
+
+	stackDriftDetectedMinTimeout = 5 * time.Second
+)
+
+func WaitStackDriftDetected(conn *cloudformation.CloudFormation, detectionID string, timeout time.Duration) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{cloudformation.StackDriftDetectionStatusDetectionInProgress},
+		Target:     []string{cloudformation.StackDriftDetectionStatusDetectionComplete},
+		Refresh:    StatusStackDriftDetection(conn, detectionID),
+		Timeout:    timeout,
+		MinTimeout: stackDriftDetectedMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*cloudformation.DescribeStackDriftDetectionStatusOutput); ok {
+		if status := aws.StringValue(output.DetectionStatus); status == cloudformation.StackDriftDetectionStatusDetectionFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.DetectionStatusReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	// Default maximum amount of time to wait for a Stack to be Created
 	StackCreatedDefaultTimeout = 30 * time.Minute
@@ -9,3 +9,9 @@ func StreamType_Values() []string {
 		StreamTypeKinesis,
 	}
 }
+
+// The CloudFront API does not define constants for invalidation statuses.
+const (
+	InvalidationStatusCompleted  = "Completed"
+	InvalidationStatusInProgress = "InProgress"
+)
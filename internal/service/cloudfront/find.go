@@ -258,3 +258,29 @@ func FindResponseHeadersPolicyByID(conn *cloudfront.CloudFront, id string) (*clo
 
 	return output, nil
 }
+
+func FindInvalidationByDistributionIDAndID(conn *cloudfront.CloudFront, distributionID, id string) (*cloudfront.Invalidation, error) {
+	input := &cloudfront.GetInvalidationInput{
+		DistributionId: aws.String(distributionID),
+		Id:             aws.String(id),
+	}
+
+	output, err := conn.GetInvalidation(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchDistribution, cloudfront.ErrCodeNoSuchInvalidation) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Invalidation == nil || output.Invalidation.InvalidationBatch == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Invalidation, nil
+}
@@ -0,0 +1,25 @@
+package cloudfront
+
+import (
+	"fmt"
+	"strings"
+)
+
+const invalidationResourceIDSeparator = ","
+
+func InvalidationCreateResourceID(distributionID, id string) string {
+	parts := []string{distributionID, id}
+	resourceID := strings.Join(parts, invalidationResourceIDSeparator)
+
+	return resourceID
+}
+
+func InvalidationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, invalidationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DISTRIBUTIONID%[2]sINVALIDATIONID", id, invalidationResourceIDSeparator)
+}
@@ -0,0 +1,252 @@
+package cloudfront
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Limits on the paths of in-progress invalidations.
+// See https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/Invalidation.html#InvalidationLimits.
+const (
+	invalidationMaxPaths         = 3000
+	invalidationMaxWildcardPaths = 15
+)
+
+func ResourceInvalidation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInvalidationCreate,
+		Read:   resourceInvalidationRead,
+		Update: resourceInvalidationUpdate,
+		Delete: resourceInvalidationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceInvalidationImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(InvalidationCompletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"distribution_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"invalidation_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"paths": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "must begin with /"),
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceInvalidationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	distributionID := d.Get("distribution_id").(string)
+	batches := splitInvalidationPaths(aws.StringValueSlice(flex.ExpandStringSet(d.Get("paths").(*schema.Set))))
+	timeout := d.Timeout(schema.TimeoutCreate)
+	var ids []string
+
+	for i, paths := range batches {
+		input := &cloudfront.CreateInvalidationInput{
+			DistributionId: aws.String(distributionID),
+			InvalidationBatch: &cloudfront.InvalidationBatch{
+				CallerReference: aws.String(resource.UniqueId()),
+				Paths: &cloudfront.Paths{
+					Items:    aws.StringSlice(paths),
+					Quantity: aws.Int64(int64(len(paths))),
+				},
+			},
+		}
+
+		log.Printf("[DEBUG] Creating CloudFront Invalidation (%d/%d) for Distribution (%s)", i+1, len(batches), distributionID)
+		outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(timeout, func() (interface{}, error) {
+			return conn.CreateInvalidation(input)
+		}, cloudfront.ErrCodeTooManyInvalidationsInProgress)
+
+		if err != nil {
+			return fmt.Errorf("error creating CloudFront Invalidation for Distribution (%s): %w", distributionID, err)
+		}
+
+		id := aws.StringValue(outputRaw.(*cloudfront.CreateInvalidationOutput).Invalidation.Id)
+		ids = append(ids, id)
+
+		if i == 0 {
+			d.SetId(InvalidationCreateResourceID(distributionID, id))
+		}
+
+		d.Set("invalidation_ids", ids)
+
+		// Batches count towards the limits on in-progress invalidations, so each one is waited for before the next is created.
+		if i < len(batches)-1 || d.Get("wait_for_completion").(bool) {
+			if _, err := waitInvalidationCompleted(conn, distributionID, id, timeout); err != nil {
+				return fmt.Errorf("error waiting for CloudFront Invalidation (%s) for Distribution (%s) to complete: %w", id, distributionID, err)
+			}
+		}
+	}
+
+	return resourceInvalidationRead(d, meta)
+}
+
+func resourceInvalidationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	distributionID, id, err := InvalidationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	ids := aws.StringValueSlice(flex.ExpandStringList(d.Get("invalidation_ids").([]interface{})))
+
+	if len(ids) == 0 {
+		ids = []string{id}
+	}
+
+	var createTime *time.Time
+	var paths []string
+	status := InvalidationStatusCompleted
+
+	for _, id := range ids {
+		invalidation, err := FindInvalidationByDistributionIDAndID(conn, distributionID, id)
+
+		// CloudFront only keeps the history of recent invalidations. Invalidations can't change,
+		// so the state of an invalidation that is no longer listed is kept as is.
+		if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchInvalidation) {
+			log.Printf("[WARN] CloudFront Invalidation (%s) for Distribution (%s) no longer in history, skipping refresh", id, distributionID)
+			return nil
+		}
+
+		if !d.IsNewResource() && tfresource.NotFound(err) {
+			log.Printf("[WARN] CloudFront Invalidation (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading CloudFront Invalidation (%s) for Distribution (%s): %w", id, distributionID, err)
+		}
+
+		if createTime == nil {
+			createTime = invalidation.CreateTime
+		}
+
+		if v := invalidation.InvalidationBatch.Paths; v != nil {
+			paths = append(paths, aws.StringValueSlice(v.Items)...)
+		}
+
+		if aws.StringValue(invalidation.Status) != InvalidationStatusCompleted {
+			status = aws.StringValue(invalidation.Status)
+		}
+	}
+
+	if createTime != nil {
+		d.Set("create_time", aws.TimeValue(createTime).Format(time.RFC3339))
+	}
+	d.Set("distribution_id", distributionID)
+	d.Set("invalidation_ids", ids)
+	d.Set("paths", paths)
+	d.Set("status", status)
+
+	return nil
+}
+
+func resourceInvalidationUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Only wait_for_completion can be updated, which has no effect on existing invalidations.
+	return resourceInvalidationRead(d, meta)
+}
+
+func resourceInvalidationDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] CloudFront Invalidations cannot be deleted, removing (%s) from state", d.Id())
+
+	return nil
+}
+
+func resourceInvalidationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := InvalidationParseResourceID(d.Id()); err != nil {
+		return nil, err
+	}
+
+	d.Set("wait_for_completion", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// splitInvalidationPaths splits paths into batches within the limits on the paths of a single invalidation.
+func splitInvalidationPaths(paths []string) [][]string {
+	sorted := make([]string, len(paths))
+	copy(sorted, paths)
+	sort.Strings(sorted)
+
+	var batches [][]string
+	var batch []string
+	wildcards := 0
+
+	for _, path := range sorted {
+		isWildcard := strings.HasSuffix(path, "*")
+
+		if len(batch) == invalidationMaxPaths || (isWildcard && wildcards == invalidationMaxWildcardPaths) {
+			batches = append(batches, batch)
+			batch = nil
+			wildcards = 0
+		}
+
+		batch = append(batch, path)
+
+		if isWildcard {
+			wildcards++
+		}
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
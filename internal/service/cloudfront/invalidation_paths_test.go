@@ -0,0 +1,61 @@
+package cloudfront
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestSplitInvalidationPaths(t *testing.T) {
+	var files []string
+	for i := 0; i < invalidationMaxPaths+1; i++ {
+		files = append(files, fmt.Sprintf("/%04d.html", i))
+	}
+
+	var wildcards []string
+	for i := 0; i < invalidationMaxWildcardPaths+1; i++ {
+		wildcards = append(wildcards, fmt.Sprintf("/%02d/*", i))
+	}
+
+	testCases := []struct {
+		Name     string
+		Paths    []string
+		Expected []int
+	}{
+		{
+			Name:     "single batch",
+			Paths:    []string{"/index.html", "/images/*"},
+			Expected: []int{2},
+		},
+		{
+			Name:     "too many paths",
+			Paths:    files,
+			Expected: []int{invalidationMaxPaths, 1},
+		},
+		{
+			Name:     "too many wildcard paths",
+			Paths:    append([]string{"/index.html"}, wildcards...),
+			Expected: []int{invalidationMaxWildcardPaths, 2},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var got []int
+			count := 0
+
+			for _, batch := range splitInvalidationPaths(testCase.Paths) {
+				got = append(got, len(batch))
+				count += len(batch)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("expected batch sizes %v, got %v", testCase.Expected, got)
+			}
+
+			if count != len(testCase.Paths) {
+				t.Errorf("expected %d paths, got %d", len(testCase.Paths), count)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package cloudfront_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+)
+
+func TestAccCloudFrontInvalidation_basic(t *testing.T) {
+	var v cloudfront.Invalidation
+	resourceName := "aws_cloudfront_invalidation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvalidationConfig("v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInvalidationExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "invalidation_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "paths.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "paths.*", "/index.html"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "paths.*", "/assets/*"),
+					resource.TestCheckResourceAttr(resourceName, "status", tfcloudfront.InvalidationStatusCompleted),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontInvalidation_triggers(t *testing.T) {
+	var v1, v2 cloudfront.Invalidation
+	resourceName := "aws_cloudfront_invalidation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvalidationConfig("v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInvalidationExists(resourceName, &v1),
+				),
+			},
+			{
+				Config: testAccInvalidationConfig("v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInvalidationExists(resourceName, &v2),
+					testAccCheckInvalidationRecreated(&v1, &v2),
+				),
+			},
+		},
+	})
+}
+
+func TestInvalidationRead_notInHistory(t *testing.T) {
+	r := tfcloudfront.ResourceInvalidation()
+	meta := acctest.UnitTestClient(t, acctest.UnitTestFakes{
+		"GetInvalidation2020_05_31": func(input interface{}) (interface{}, error) {
+			return nil, awserr.New(cloudfront.ErrCodeNoSuchInvalidation, "The specified invalidation does not exist.", nil)
+		},
+	})
+
+	state := acctest.UnitTestState("E2EXAMPLE,I2EXAMPLE", map[string]string{
+		"distribution_id":    "E2EXAMPLE",
+		"invalidation_ids.#": "1",
+		"invalidation_ids.0": "I2EXAMPLE",
+		"paths.#":            "1",
+		"paths.0":            "/*",
+		"status":             tfcloudfront.InvalidationStatusCompleted,
+	})
+
+	d, err := acctest.UnitTestRead(t, r, state, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := d.Id(), "E2EXAMPLE,I2EXAMPLE"; got != expected {
+		t.Errorf("expected ID %q, got %q", expected, got)
+	}
+
+	if got, expected := d.Get("paths.0").(string), "/*"; got != expected {
+		t.Errorf("expected paths.0 %q, got %q", expected, got)
+	}
+}
+
+func TestInvalidationRead_distributionNotFound(t *testing.T) {
+	r := tfcloudfront.ResourceInvalidation()
+	meta := acctest.UnitTestClient(t, acctest.UnitTestFakes{
+		"GetInvalidation2020_05_31": func(input interface{}) (interface{}, error) {
+			return nil, awserr.New(cloudfront.ErrCodeNoSuchDistribution, "The specified distribution does not exist.", nil)
+		},
+	})
+
+	state := acctest.UnitTestState("E2EXAMPLE,I2EXAMPLE", map[string]string{
+		"distribution_id": "E2EXAMPLE",
+	})
+
+	d, err := acctest.UnitTestRead(t, r, state, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("expected resource to be removed from state, got ID %q", d.Id())
+	}
+}
+
+func testAccCheckInvalidationExists(n string, v *cloudfront.Invalidation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFront Invalidation ID is set")
+		}
+
+		distributionID, id, err := tfcloudfront.InvalidationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn
+
+		output, err := tfcloudfront.FindInvalidationByDistributionIDAndID(conn, distributionID, id)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckInvalidationRecreated(before, after *cloudfront.Invalidation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.Id), aws.StringValue(after.Id); before == after {
+			return fmt.Errorf("CloudFront Invalidation (%s) not recreated", before)
+		}
+
+		return nil
+	}
+}
+
+func testAccInvalidationConfig(version string) string {
+	return acctest.ConfigCompose(
+		testAccMonitoringSubscriptionBaseConfig(),
+		fmt.Sprintf(`
+resource "aws_cloudfront_invalidation" "test" {
+  distribution_id = aws_cloudfront_distribution.test.id
+  paths           = ["/index.html", "/assets/*"]
+
+  triggers = {
+    version = %[1]q
+  }
+}
+`, version))
+}
@@ -0,0 +1,24 @@
+package cloudfront
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusInvalidation(conn *cloudfront.CloudFront, distributionID, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindInvalidationByDistributionIDAndID(conn, distributionID, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,32 @@
+package cloudfront
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	InvalidationCompletedTimeout = 30 * time.Minute
+)
+
+func waitInvalidationCompleted(conn *cloudfront.CloudFront, distributionID, id string, timeout time.Duration) (*cloudfront.Invalidation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{InvalidationStatusInProgress},
+		Target:     []string{InvalidationStatusCompleted},
+		Refresh:    statusInvalidation(conn, distributionID, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForState(stateConf)
+
+	if output, ok := outputRaw.(*cloudfront.Invalidation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,278 @@
+package cloudwatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+// Dashboard grid dimensions.
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html.
+const (
+	dashboardGridColumns   = 24
+	dashboardWidgetMaxRows = 1000
+)
+
+const (
+	dashboardWidgetTypeAlarm    = "alarm"
+	dashboardWidgetTypeExplorer = "explorer"
+	dashboardWidgetTypeLog      = "log"
+	dashboardWidgetTypeMetric   = "metric"
+	dashboardWidgetTypeText     = "text"
+)
+
+var (
+	// Metric math identifiers must start with a lowercase letter.
+	dashboardMetricIDRegexp = regexp.MustCompile(`^[a-z][a-zA-Z0-9_]*$`)
+
+	dashboardExpressionIdentifierRegexp = regexp.MustCompile(`\b[a-z][a-zA-Z0-9_]*\b`)
+	dashboardExpressionStringRegexp     = regexp.MustCompile(`'[^']*'|"[^"]*"`)
+)
+
+type dashboardDocument struct {
+	End            string             `json:"end,omitempty"`
+	PeriodOverride string             `json:"periodOverride,omitempty"`
+	Start          string             `json:"start,omitempty"`
+	Widgets        []*dashboardWidget `json:"widgets"`
+}
+
+type dashboardWidget struct {
+	Type       string                 `json:"type"`
+	X          *int                   `json:"x,omitempty"`
+	Y          *int                   `json:"y,omitempty"`
+	Width      int                    `json:"width"`
+	Height     int                    `json:"height"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// dashboardMetricQuery is a metric or metric math expression graphed by a metric widget.
+type dashboardMetricQuery struct {
+	Color      string
+	Dimensions map[string]string
+	Expression string
+	ID         string
+	Label      string
+	MetricName string
+	Namespace  string
+	Period     int
+	Stat       string
+	Visible    bool
+	YAxis      string
+}
+
+// render returns the JSON array of a metric widget's "metrics" property for the query.
+func (q dashboardMetricQuery) render() []interface{} {
+	options := map[string]interface{}{}
+
+	if q.Color != "" {
+		options["color"] = q.Color
+	}
+	if q.ID != "" {
+		options["id"] = q.ID
+	}
+	if q.Label != "" {
+		options["label"] = q.Label
+	}
+	if !q.Visible {
+		options["visible"] = false
+	}
+	if q.YAxis == "right" {
+		options["yAxis"] = q.YAxis
+	}
+
+	if q.Expression != "" {
+		options["expression"] = q.Expression
+
+		return []interface{}{options}
+	}
+
+	if q.Period > 0 {
+		options["period"] = q.Period
+	}
+	if q.Stat != "" {
+		options["stat"] = q.Stat
+	}
+
+	entry := []interface{}{q.Namespace, q.MetricName}
+
+	names := make([]string, 0, len(q.Dimensions))
+	for name := range q.Dimensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		entry = append(entry, name, q.Dimensions[name])
+	}
+
+	if len(options) > 0 {
+		entry = append(entry, options)
+	}
+
+	return entry
+}
+
+// validateDashboardMetricQueries checks that each query is either a metric or an expression,
+// that IDs are valid and unique and that expressions only reference IDs of the other queries.
+func validateDashboardMetricQueries(queries []dashboardMetricQuery) error {
+	ids := make(map[string]struct{})
+
+	for _, q := range queries {
+		if q.ID == "" {
+			continue
+		}
+
+		if !dashboardMetricIDRegexp.MatchString(q.ID) {
+			return fmt.Errorf("metric query ID (%s) must start with a lowercase letter and contain only letters, numbers and underscores", q.ID)
+		}
+
+		if _, ok := ids[q.ID]; ok {
+			return fmt.Errorf("duplicate metric query ID (%s)", q.ID)
+		}
+
+		ids[q.ID] = struct{}{}
+	}
+
+	for _, q := range queries {
+		if (q.Expression == "") == (q.MetricName == "") {
+			return fmt.Errorf("metric query must specify exactly one of expression or metric")
+		}
+
+		if q.Expression == "" {
+			continue
+		}
+
+		// Metrics Insights queries reference metric and dimension names rather than metric query IDs.
+		if strings.HasPrefix(strings.ToUpper(strings.TrimSpace(q.Expression)), "SELECT ") {
+			continue
+		}
+
+		// Function names are uppercase and string literals can contain anything, so only
+		// lowercase identifiers outside of string literals are metric query references.
+		expression := dashboardExpressionStringRegexp.ReplaceAllString(q.Expression, "''")
+
+		for _, ref := range dashboardExpressionIdentifierRegexp.FindAllString(expression, -1) {
+			if ref == q.ID {
+				return fmt.Errorf("metric math expression (%s) references its own ID (%s)", q.Expression, ref)
+			}
+
+			if _, ok := ids[ref]; !ok {
+				return fmt.Errorf("metric math expression (%s) references unknown metric query ID (%s)", q.Expression, ref)
+			}
+		}
+	}
+
+	return nil
+}
+
+// layoutDashboardWidgets assigns grid positions to the widgets without one.
+// Widgets with a position are placed first. The remaining widgets flow left to right, top to bottom
+// in configuration order, each placed at the first free position after the previous one.
+func layoutDashboardWidgets(widgets []*dashboardWidget) error {
+	var occupied [][dashboardGridColumns]bool
+
+	fits := func(x, y, width, height int) bool {
+		if x+width > dashboardGridColumns {
+			return false
+		}
+
+		for row := y; row < y+height && row < len(occupied); row++ {
+			for col := x; col < x+width; col++ {
+				if occupied[row][col] {
+					return false
+				}
+			}
+		}
+
+		return true
+	}
+
+	occupy := func(x, y, width, height int) {
+		for len(occupied) < y+height {
+			occupied = append(occupied, [dashboardGridColumns]bool{})
+		}
+
+		for row := y; row < y+height; row++ {
+			for col := x; col < x+width; col++ {
+				occupied[row][col] = true
+			}
+		}
+	}
+
+	for i, widget := range widgets {
+		if widget.Width < 1 || widget.Width > dashboardGridColumns {
+			return fmt.Errorf("widget %d width (%d) must be between 1 and %d", i, widget.Width, dashboardGridColumns)
+		}
+
+		if widget.Height < 1 || widget.Height > dashboardWidgetMaxRows {
+			return fmt.Errorf("widget %d height (%d) must be between 1 and %d", i, widget.Height, dashboardWidgetMaxRows)
+		}
+
+		if widget.X == nil || widget.Y == nil {
+			continue
+		}
+
+		x, y := *widget.X, *widget.Y
+
+		if x < 0 || y < 0 || x+widget.Width > dashboardGridColumns {
+			return fmt.Errorf("widget %d position (%d, %d) with width %d is outside of the %d column grid", i, x, y, widget.Width, dashboardGridColumns)
+		}
+
+		if !fits(x, y, widget.Width, widget.Height) {
+			return fmt.Errorf("widget %d at position (%d, %d) overlaps another widget", i, x, y)
+		}
+
+		occupy(x, y, widget.Width, widget.Height)
+	}
+
+	cursorX, cursorY := 0, 0
+
+	for _, widget := range widgets {
+		if widget.X != nil && widget.Y != nil {
+			continue
+		}
+
+		x, y := cursorX, cursorY
+
+		for !fits(x, y, widget.Width, widget.Height) {
+			x++
+
+			if x+widget.Width > dashboardGridColumns {
+				x = 0
+				y++
+			}
+		}
+
+		occupy(x, y, widget.Width, widget.Height)
+
+		widget.X, widget.Y = aws.Int(x), aws.Int(y)
+		cursorX, cursorY = x+widget.Width, y
+
+		if cursorX >= dashboardGridColumns {
+			cursorX, cursorY = 0, y+1
+		}
+	}
+
+	return nil
+}
+
+// renderDashboardDocument lays out the document's widgets and returns the normalized dashboard body.
+func renderDashboardDocument(doc *dashboardDocument) (string, error) {
+	if err := layoutDashboardWidgets(doc.Widgets); err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(doc)
+
+	if err != nil {
+		return "", err
+	}
+
+	// Normalize the document the same way as aws_cloudwatch_dashboard's dashboard_body.
+	return structure.NormalizeJsonString(string(b))
+}
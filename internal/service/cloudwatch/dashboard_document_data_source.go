@@ -0,0 +1,669 @@
+package cloudwatch
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceDashboardDocument() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDashboardDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"end": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"start"},
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"period_override": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"auto", "inherit"}, false),
+			},
+			"start": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"widget": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 500,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"alarms": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+									"sort_by": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"default", "stateUpdatedTimestamp", "timestamp"}, false),
+									},
+									"states": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(cloudwatch.StateValue_Values(), false),
+										},
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"explorer": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"label": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"legend_position": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"bottom", "hidden", "right"}, false),
+									},
+									"metric": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"metric_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"resource_type": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"stat": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Default:  cloudwatch.StatisticAverage,
+												},
+											},
+										},
+									},
+									"period": dashboardDocumentPeriodSchema(),
+									"region": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"rows_per_page": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"split_by": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"bar", "pie", "timeSeries"}, false),
+									},
+									"widgets_per_row": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"height": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      6,
+							ValidateFunc: validation.IntBetween(1, dashboardWidgetMaxRows),
+						},
+						"log": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group_names": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 50,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"query": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "table",
+										ValidateFunc: validation.StringInSlice([]string{"bar", "pie", "table", "timeSeries"}, false),
+									},
+								},
+							},
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_query": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"color": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringMatch(regexp.MustCompile(`^#[0-9a-fA-F]{6}$`), "must be a hex color code such as #1f77b4"),
+												},
+												"expression": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+												"id": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												"label": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"metric": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"dimensions": {
+																Type:     schema.TypeMap,
+																Optional: true,
+																Elem:     &schema.Schema{Type: schema.TypeString},
+															},
+															"metric_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 255),
+															},
+															"namespace": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 255),
+															},
+															"period": dashboardDocumentPeriodSchema(),
+															"stat": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+												"visible": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  true,
+												},
+												"y_axis": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Default:      "left",
+													ValidateFunc: validation.StringInSlice([]string{"left", "right"}, false),
+												},
+											},
+										},
+									},
+									"period": dashboardDocumentPeriodSchema(),
+									"region": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"stat": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "timeSeries",
+										ValidateFunc: validation.StringInSlice([]string{"bar", "gauge", "pie", "singleValue", "timeSeries"}, false),
+									},
+								},
+							},
+						},
+						"position": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"x": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, dashboardGridColumns-1),
+									},
+									"y": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+								},
+							},
+						},
+						"text": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"background": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"solid", "transparent"}, false),
+									},
+									"markdown": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"width": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      6,
+							ValidateFunc: validation.IntBetween(1, dashboardGridColumns),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dashboardDocumentPeriodSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeInt,
+		Optional: true,
+		ValidateFunc: validation.Any(
+			validation.IntInSlice([]int{1, 5, 10, 30}),
+			validation.IntDivisibleBy(60),
+		),
+	}
+}
+
+func dataSourceDashboardDocumentRead(d *schema.ResourceData, meta interface{}) error {
+	region := meta.(*conns.AWSClient).Region
+
+	doc := &dashboardDocument{
+		End:            d.Get("end").(string),
+		PeriodOverride: d.Get("period_override").(string),
+		Start:          d.Get("start").(string),
+	}
+
+	for i, tfMapRaw := range d.Get("widget").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		widget, err := expandDashboardWidget(tfMap, region)
+
+		if err != nil {
+			return fmt.Errorf("error reading widget %d: %w", i, err)
+		}
+
+		doc.Widgets = append(doc.Widgets, widget)
+	}
+
+	jsonString, err := renderDashboardDocument(doc)
+
+	if err != nil {
+		return fmt.Errorf("error rendering CloudWatch Dashboard document: %w", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	d.Set("json", jsonString)
+
+	return nil
+}
+
+func expandDashboardWidget(tfMap map[string]interface{}, region string) (*dashboardWidget, error) {
+	widget := &dashboardWidget{
+		Height: tfMap["height"].(int),
+		Width:  tfMap["width"].(int),
+	}
+
+	if v, ok := tfMap["position"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		position := v[0].(map[string]interface{})
+		widget.X = aws.Int(position["x"].(int))
+		widget.Y = aws.Int(position["y"].(int))
+	}
+
+	var types []string
+
+	for _, widgetType := range []string{dashboardWidgetTypeAlarm, dashboardWidgetTypeExplorer, dashboardWidgetTypeLog, dashboardWidgetTypeMetric, dashboardWidgetTypeText} {
+		v, ok := tfMap[widgetType].([]interface{})
+
+		if !ok || len(v) == 0 || v[0] == nil {
+			continue
+		}
+
+		types = append(types, widgetType)
+		properties := v[0].(map[string]interface{})
+
+		switch widgetType {
+		case dashboardWidgetTypeAlarm:
+			widget.Properties = expandDashboardAlarmWidgetProperties(properties)
+		case dashboardWidgetTypeExplorer:
+			widget.Properties = expandDashboardExplorerWidgetProperties(properties, region)
+		case dashboardWidgetTypeLog:
+			widget.Properties = expandDashboardLogWidgetProperties(properties, region)
+		case dashboardWidgetTypeMetric:
+			var err error
+			widget.Properties, err = expandDashboardMetricWidgetProperties(properties, region)
+
+			if err != nil {
+				return nil, err
+			}
+		case dashboardWidgetTypeText:
+			widget.Properties = expandDashboardTextWidgetProperties(properties)
+		}
+	}
+
+	if len(types) != 1 {
+		return nil, fmt.Errorf("exactly one of alarm, explorer, log, metric or text must be configured, got %d", len(types))
+	}
+
+	widget.Type = types[0]
+
+	return widget, nil
+}
+
+func expandDashboardAlarmWidgetProperties(tfMap map[string]interface{}) map[string]interface{} {
+	properties := map[string]interface{}{
+		"alarms": aws.StringValueSlice(flex.ExpandStringList(tfMap["alarms"].([]interface{}))),
+	}
+
+	if v, ok := tfMap["sort_by"].(string); ok && v != "" {
+		properties["sortBy"] = v
+	}
+
+	if v, ok := tfMap["states"].(*schema.Set); ok && v.Len() > 0 {
+		properties["states"] = aws.StringValueSlice(flex.ExpandStringSet(v))
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		properties["title"] = v
+	}
+
+	return properties
+}
+
+func expandDashboardExplorerWidgetProperties(tfMap map[string]interface{}, region string) map[string]interface{} {
+	var labels []interface{}
+
+	for _, tfMapRaw := range tfMap["label"].([]interface{}) {
+		label, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := map[string]interface{}{
+			"key": label["key"].(string),
+		}
+
+		if v := label["value"].(string); v != "" {
+			apiObject["value"] = v
+		}
+
+		labels = append(labels, apiObject)
+	}
+
+	var metrics []interface{}
+
+	for _, tfMapRaw := range tfMap["metric"].([]interface{}) {
+		metric, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		metrics = append(metrics, map[string]interface{}{
+			"metricName":   metric["metric_name"].(string),
+			"resourceType": metric["resource_type"].(string),
+			"stat":         metric["stat"].(string),
+		})
+	}
+
+	widgetOptions := map[string]interface{}{
+		"stacked": tfMap["stacked"].(bool),
+	}
+
+	if v := tfMap["legend_position"].(string); v != "" {
+		widgetOptions["legend"] = map[string]interface{}{
+			"position": v,
+		}
+	}
+
+	if v := tfMap["rows_per_page"].(int); v > 0 {
+		widgetOptions["rowsPerPage"] = v
+	}
+
+	if v := tfMap["view"].(string); v != "" {
+		widgetOptions["view"] = v
+	}
+
+	if v := tfMap["widgets_per_row"].(int); v > 0 {
+		widgetOptions["widgetsPerRow"] = v
+	}
+
+	properties := map[string]interface{}{
+		"labels":        labels,
+		"metrics":       metrics,
+		"region":        dashboardWidgetRegion(tfMap, region),
+		"widgetOptions": widgetOptions,
+	}
+
+	if v := tfMap["period"].(int); v > 0 {
+		properties["period"] = v
+	}
+
+	if v := tfMap["split_by"].(string); v != "" {
+		properties["splitBy"] = v
+	}
+
+	if v := tfMap["title"].(string); v != "" {
+		properties["title"] = v
+	}
+
+	return properties
+}
+
+func expandDashboardLogWidgetProperties(tfMap map[string]interface{}, region string) map[string]interface{} {
+	var sources []string
+
+	for _, v := range aws.StringValueSlice(flex.ExpandStringList(tfMap["log_group_names"].([]interface{}))) {
+		sources = append(sources, fmt.Sprintf("SOURCE '%s'", v))
+	}
+
+	properties := map[string]interface{}{
+		"query":   strings.Join(append(sources, tfMap["query"].(string)), " | "),
+		"region":  dashboardWidgetRegion(tfMap, region),
+		"stacked": tfMap["stacked"].(bool),
+		"view":    tfMap["view"].(string),
+	}
+
+	if v := tfMap["title"].(string); v != "" {
+		properties["title"] = v
+	}
+
+	return properties
+}
+
+func expandDashboardMetricWidgetProperties(tfMap map[string]interface{}, region string) (map[string]interface{}, error) {
+	var queries []dashboardMetricQuery
+
+	for _, tfMapRaw := range tfMap["metric_query"].([]interface{}) {
+		query, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := dashboardMetricQuery{
+			Color:      query["color"].(string),
+			Expression: query["expression"].(string),
+			ID:         query["id"].(string),
+			Label:      query["label"].(string),
+			Visible:    query["visible"].(bool),
+			YAxis:      query["y_axis"].(string),
+		}
+
+		if v, ok := query["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			metric := v[0].(map[string]interface{})
+
+			apiObject.Dimensions = aws.StringValueMap(flex.ExpandStringMap(metric["dimensions"].(map[string]interface{})))
+			apiObject.MetricName = metric["metric_name"].(string)
+			apiObject.Namespace = metric["namespace"].(string)
+			apiObject.Period = metric["period"].(int)
+			apiObject.Stat = metric["stat"].(string)
+		}
+
+		queries = append(queries, apiObject)
+	}
+
+	if err := validateDashboardMetricQueries(queries); err != nil {
+		return nil, err
+	}
+
+	var metrics []interface{}
+
+	for _, query := range queries {
+		metrics = append(metrics, query.render())
+	}
+
+	properties := map[string]interface{}{
+		"metrics": metrics,
+		"region":  dashboardWidgetRegion(tfMap, region),
+		"stacked": tfMap["stacked"].(bool),
+		"view":    tfMap["view"].(string),
+	}
+
+	if v := tfMap["period"].(int); v > 0 {
+		properties["period"] = v
+	}
+
+	if v := tfMap["stat"].(string); v != "" {
+		properties["stat"] = v
+	}
+
+	if v := tfMap["title"].(string); v != "" {
+		properties["title"] = v
+	}
+
+	return properties, nil
+}
+
+func expandDashboardTextWidgetProperties(tfMap map[string]interface{}) map[string]interface{} {
+	properties := map[string]interface{}{
+		"markdown": tfMap["markdown"].(string),
+	}
+
+	if v := tfMap["background"].(string); v != "" {
+		properties["background"] = v
+	}
+
+	return properties
+}
+
+// dashboardWidgetRegion returns the widget's configured Region, defaulting to the provider's Region.
+func dashboardWidgetRegion(tfMap map[string]interface{}, region string) string {
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		return v
+	}
+
+	return region
+}
@@ -0,0 +1,130 @@
+package cloudwatch_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudWatchDashboardDocumentDataSource_basic(t *testing.T) {
+	var dashboard cloudwatch.GetDashboardOutput
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+	resourceName := "aws_cloudwatch_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchDashboardExists(resourceName, &dashboard),
+					resource.TestMatchResourceAttr(dataSourceName, "json", regexp.MustCompile(`"type":"metric","width":12,"x":0,"y":1`)),
+					resource.TestCheckResourceAttrPair(resourceName, "dashboard_body", dataSourceName, "json"),
+				),
+			},
+			{
+				Config:   testAccDashboardDocumentDataSourceConfig_basic(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_invalidExpression(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_invalidExpression,
+				ExpectError: regexp.MustCompile(`references unknown metric query ID \(m2\)`),
+			},
+		},
+	})
+}
+
+func testAccDashboardDocumentDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    width  = 24
+    height = 1
+
+    text {
+      markdown = "# %[1]s"
+    }
+  }
+
+  widget {
+    width = 12
+
+    metric {
+      title = "CPU"
+
+      metric_query {
+        id = "m1"
+
+        metric {
+          namespace   = "AWS/EC2"
+          metric_name = "CPUUtilization"
+          stat        = "Maximum"
+        }
+      }
+
+      metric_query {
+        id         = "e1"
+        expression = "m1 / 100"
+        label      = "Ratio"
+        y_axis     = "right"
+      }
+    }
+  }
+
+  widget {
+    width = 12
+
+    log {
+      log_group_names = ["/aws/lambda/%[1]s"]
+      query           = "fields @timestamp, @message | sort @timestamp desc | limit 20"
+    }
+  }
+}
+
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = %[1]q
+  dashboard_body = data.aws_cloudwatch_dashboard_document.test.json
+}
+`, rName)
+}
+
+const testAccDashboardDocumentDataSourceConfig_invalidExpression = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    metric {
+      metric_query {
+        id = "m1"
+
+        metric {
+          namespace   = "AWS/EC2"
+          metric_name = "CPUUtilization"
+        }
+      }
+
+      metric_query {
+        id         = "e1"
+        expression = "m1 + m2"
+      }
+    }
+  }
+}
+`
@@ -0,0 +1,275 @@
+package cloudwatch
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+func TestLayoutDashboardWidgets(t *testing.T) {
+	type position struct {
+		X, Y int
+	}
+
+	testCases := []struct {
+		Name     string
+		Widgets  []*dashboardWidget
+		Expected []position
+	}{
+		{
+			Name: "flow",
+			Widgets: []*dashboardWidget{
+				{Width: 12, Height: 6},
+				{Width: 12, Height: 6},
+				{Width: 24, Height: 3},
+				{Width: 6, Height: 6},
+			},
+			Expected: []position{{0, 0}, {12, 0}, {0, 6}, {0, 9}},
+		},
+		{
+			Name: "wrap",
+			Widgets: []*dashboardWidget{
+				{Width: 8, Height: 6},
+				{Width: 8, Height: 6},
+				{Width: 10, Height: 6},
+			},
+			Expected: []position{{0, 0}, {8, 0}, {0, 6}},
+		},
+		{
+			Name: "mixed heights",
+			Widgets: []*dashboardWidget{
+				{Width: 12, Height: 12},
+				{Width: 12, Height: 6},
+				{Width: 12, Height: 6},
+				{Width: 12, Height: 6},
+			},
+			Expected: []position{{0, 0}, {12, 0}, {12, 6}, {0, 12}},
+		},
+		{
+			Name: "explicit positions",
+			Widgets: []*dashboardWidget{
+				{Width: 6, Height: 6},
+				{Width: 6, Height: 6, X: aws.Int(6), Y: aws.Int(0)},
+				{Width: 6, Height: 6},
+			},
+			Expected: []position{{0, 0}, {6, 0}, {12, 0}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if err := layoutDashboardWidgets(testCase.Widgets); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for i, widget := range testCase.Widgets {
+				got := position{aws.IntValue(widget.X), aws.IntValue(widget.Y)}
+
+				if got != testCase.Expected[i] {
+					t.Errorf("widget %d: expected position %v, got %v", i, testCase.Expected[i], got)
+				}
+			}
+		})
+	}
+}
+
+func TestLayoutDashboardWidgetsErrors(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Widgets  []*dashboardWidget
+		Expected string
+	}{
+		{
+			Name:     "outside grid",
+			Widgets:  []*dashboardWidget{{Width: 12, Height: 6, X: aws.Int(18), Y: aws.Int(0)}},
+			Expected: "widget 0 position (18, 0) with width 12 is outside of the 24 column grid",
+		},
+		{
+			Name: "overlap",
+			Widgets: []*dashboardWidget{
+				{Width: 12, Height: 6, X: aws.Int(0), Y: aws.Int(0)},
+				{Width: 12, Height: 6, X: aws.Int(6), Y: aws.Int(3)},
+			},
+			Expected: "widget 1 at position (6, 3) overlaps another widget",
+		},
+		{
+			Name:     "width",
+			Widgets:  []*dashboardWidget{{Width: 25, Height: 6}},
+			Expected: "widget 0 width (25) must be between 1 and 24",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := layoutDashboardWidgets(testCase.Widgets)
+
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+
+			if err.Error() != testCase.Expected {
+				t.Errorf("expected error %q, got %q", testCase.Expected, err)
+			}
+		})
+	}
+}
+
+func TestValidateDashboardMetricQueries(t *testing.T) {
+	m1 := dashboardMetricQuery{ID: "m1", MetricName: "CPUUtilization", Namespace: "AWS/EC2"}
+	m2 := dashboardMetricQuery{ID: "m2", MetricName: "NetworkIn", Namespace: "AWS/EC2"}
+
+	testCases := []struct {
+		Name     string
+		Queries  []dashboardMetricQuery
+		Expected string
+	}{
+		{
+			Name:    "metrics",
+			Queries: []dashboardMetricQuery{{MetricName: "CPUUtilization", Namespace: "AWS/EC2"}, m1},
+		},
+		{
+			Name:    "expression",
+			Queries: []dashboardMetricQuery{m1, m2, {ID: "e1", Expression: "SUM(METRICS()) / (m1 + m2) * 100"}},
+		},
+		{
+			Name:    "search",
+			Queries: []dashboardMetricQuery{{ID: "e1", Expression: `SEARCH('{AWS/EC2,InstanceId} MetricName="CPUUtilization"', 'Average', 300)`}},
+		},
+		{
+			Name:    "metrics insights",
+			Queries: []dashboardMetricQuery{{ID: "q1", Expression: `SELECT AVG(CPUUtilization) FROM SCHEMA("AWS/EC2", InstanceId) WHERE region = 'us-west-2'`}},
+		},
+		{
+			Name:     "invalid ID",
+			Queries:  []dashboardMetricQuery{{ID: "M1", MetricName: "CPUUtilization", Namespace: "AWS/EC2"}},
+			Expected: "metric query ID (M1) must start with a lowercase letter and contain only letters, numbers and underscores",
+		},
+		{
+			Name:     "duplicate ID",
+			Queries:  []dashboardMetricQuery{m1, m1},
+			Expected: "duplicate metric query ID (m1)",
+		},
+		{
+			Name:     "metric and expression",
+			Queries:  []dashboardMetricQuery{{ID: "e1", Expression: "m1", MetricName: "CPUUtilization", Namespace: "AWS/EC2"}},
+			Expected: "metric query must specify exactly one of expression or metric",
+		},
+		{
+			Name:     "unknown reference",
+			Queries:  []dashboardMetricQuery{m1, {ID: "e1", Expression: "m1 + m3"}},
+			Expected: "metric math expression (m1 + m3) references unknown metric query ID (m3)",
+		},
+		{
+			Name:     "self reference",
+			Queries:  []dashboardMetricQuery{m1, {ID: "e1", Expression: "RATE(e1)"}},
+			Expected: "metric math expression (RATE(e1)) references its own ID (e1)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := validateDashboardMetricQueries(testCase.Queries)
+
+			if testCase.Expected == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+
+			if err.Error() != testCase.Expected {
+				t.Errorf("expected error %q, got %q", testCase.Expected, err)
+			}
+		})
+	}
+}
+
+func TestRenderDashboardDocument(t *testing.T) {
+	doc := &dashboardDocument{
+		PeriodOverride: "inherit",
+		Widgets: []*dashboardWidget{
+			{
+				Type:   dashboardWidgetTypeText,
+				Width:  24,
+				Height: 1,
+				Properties: map[string]interface{}{
+					"markdown": "# Example",
+				},
+			},
+			{
+				Type:   dashboardWidgetTypeMetric,
+				Width:  12,
+				Height: 6,
+				Properties: map[string]interface{}{
+					"metrics": []interface{}{
+						dashboardMetricQuery{
+							Dimensions: map[string]string{"InstanceId": "i-1234567890abcdef0", "AutoScalingGroupName": "example"},
+							ID:         "m1",
+							MetricName: "CPUUtilization",
+							Namespace:  "AWS/EC2",
+							Stat:       "Maximum",
+						}.render(),
+						dashboardMetricQuery{
+							Expression: "m1 / 100",
+							ID:         "e1",
+							Label:      "Ratio",
+							Visible:    true,
+							YAxis:      "right",
+						}.render(),
+					},
+					"region": "us-west-2",
+					"view":   "timeSeries",
+				},
+			},
+		},
+	}
+
+	got, err := renderDashboardDocument(doc)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected, err := structure.NormalizeJsonString(`{
+  "periodOverride": "inherit",
+  "widgets": [
+    {
+      "type": "text",
+      "x": 0,
+      "y": 0,
+      "width": 24,
+      "height": 1,
+      "properties": {"markdown": "# Example"}
+    },
+    {
+      "type": "metric",
+      "x": 0,
+      "y": 1,
+      "width": 12,
+      "height": 6,
+      "properties": {
+        "metrics": [
+          ["AWS/EC2", "CPUUtilization", "AutoScalingGroupName", "example", "InstanceId", "i-1234567890abcdef0", {"id": "m1", "stat": "Maximum", "visible": false}],
+          [{"expression": "m1 / 100", "id": "e1", "label": "Ratio", "yAxis": "right"}]
+        ],
+        "region": "us-west-2",
+        "view": "timeSeries"
+      }
+    }
+  ]
+}`)
+
+	if err != nil {
+		t.Fatalf("error normalizing expected document: %s", err)
+	}
+
+	if got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
@@ -158,3 +158,28 @@ func FindContributorInsights(ctx context.Context, conn *dynamodb.DynamoDB, table
 
 	return output, nil
 }
+
+func FindTableExportByARN(conn *dynamodb.DynamoDB, arn string) (*dynamodb.ExportDescription, error) {
+	input := &dynamodb.DescribeExportInput{
+		ExportArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeExport(input)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeExportNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ExportDescription == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ExportDescription, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceGlobalTable() *schema.Resource {
@@ -90,7 +91,7 @@ func resourceGlobalTableCreate(d *schema.ResourceData, meta interface{}) error {
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		MinTimeout: 10 * time.Second,
 	}
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(stateConf)
 	if err != nil {
 		return err
 	}
@@ -157,7 +158,7 @@ func resourceGlobalTableUpdate(d *schema.ResourceData, meta interface{}) error {
 			Timeout:    d.Timeout(schema.TimeoutUpdate),
 			MinTimeout: 10 * time.Second,
 		}
-		_, err := stateConf.WaitForState()
+		_, err := tfresource.WaitForState(stateConf)
 		if err != nil {
 			return err
 		}
@@ -192,7 +193,7 @@ func resourceGlobalTableDelete(d *schema.ResourceData, meta interface{}) error {
 		Timeout:    d.Timeout(schema.TimeoutDelete),
 		MinTimeout: 10 * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 	return err
 }
 
@@ -205,3 +205,19 @@ func statusContributorInsights(ctx context.Context, conn *dynamodb.DynamoDB, tab
 		return insight, aws.StringValue(insight.ContributorInsightsStatus), nil
 	}
 }
+
+func statusTableExport(conn *dynamodb.DynamoDB, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		export, err := FindTableExportByARN(conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return export, aws.StringValue(export.ExportStatus), nil
+	}
+}
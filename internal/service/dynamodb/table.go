@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
@@ -250,10 +251,24 @@ func ResourceTable() *schema.Resource {
 							Computed:     true,
 							ValidateFunc: verify.ValidARN,
 						},
+						"point_in_time_recovery": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"propagate_tags": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 						"region_name": {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+						"table_class_override": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.TableClass_Values(), false),
+						},
 					},
 				},
 			},
@@ -554,7 +569,9 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(d.Get("name").(string))
 
-	if _, err := waitDynamoDBTableActive(conn, d.Id()); err != nil {
+	table, err := waitDynamoDBTableActive(conn, d.Id())
+
+	if err != nil {
 		return fmt.Errorf("error waiting for creation of DynamoDB table (%s): %w", d.Id(), err)
 	}
 
@@ -565,7 +582,7 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.Get("point_in_time_recovery.0.enabled").(bool) {
-		if err := updateDynamoDbPITR(d, conn); err != nil {
+		if err := updateDynamoDbPITR(d.Id(), true, conn); err != nil {
 			return fmt.Errorf("error enabling DynamoDB Table (%s) point in time recovery: %w", d.Id(), err)
 		}
 	}
@@ -574,6 +591,12 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 		if err := createDynamoDbReplicas(d.Id(), v.List(), conn); err != nil {
 			return fmt.Errorf("error initially creating DynamoDB Table (%s) replicas: %w", d.Id(), err)
 		}
+
+		for _, tfMapRaw := range v.List() {
+			if err := updateDynamoDbReplicaSettings(d, aws.StringValue(table.TableArn), nil, tfMapRaw.(map[string]interface{}), meta); err != nil {
+				return fmt.Errorf("error initially updating DynamoDB Table (%s) replicas: %w", d.Id(), err)
+			}
+		}
 	}
 
 	return resourceTableRead(d, meta)
@@ -660,7 +683,13 @@ func resourceTableRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting server_side_encryption: %w", err)
 	}
 
-	if err := d.Set("replica", flattenDynamoDbReplicaDescriptions(table.Replicas)); err != nil {
+	replicas, err := flattenDynamoDbReplicaSettings(d, table.Replicas, meta)
+
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("replica", replicas); err != nil {
 		return fmt.Errorf("error setting replica: %w", err)
 	}
 
@@ -888,13 +917,14 @@ func resourceTableUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("point_in_time_recovery") {
-		if err := updateDynamoDbPITR(d, conn); err != nil {
+		if err := updateDynamoDbPITR(d.Id(), d.Get("point_in_time_recovery.0.enabled").(bool), conn); err != nil {
 			return fmt.Errorf("error updating DynamoDB Table (%s) point in time recovery: %w", d.Id(), err)
 		}
 	}
 
-	if d.HasChange("replica") {
-		if err := updateDynamoDbReplica(d, conn); err != nil {
+	// Tags are propagated to replicas, so replicas are also updated when tags change.
+	if d.HasChanges("replica", "tags_all") {
+		if err := updateDynamoDbReplica(d, meta); err != nil {
 			return fmt.Errorf("error updating DynamoDB Table (%s) replica: %w", d.Id(), err)
 		}
 	}
@@ -959,6 +989,10 @@ func createDynamoDbReplicas(tableName string, tfList []interface{}, conn *dynamo
 			replicaInput.KMSMasterKeyId = aws.String(v)
 		}
 
+		if v, ok := tfMap["table_class_override"].(string); ok && v != "" {
+			replicaInput.TableClassOverride = aws.String(v)
+		}
+
 		input := &dynamodb.UpdateTableInput{
 			TableName: aws.String(tableName),
 			ReplicaUpdates: []*dynamodb.ReplicationGroupUpdate{
@@ -1027,11 +1061,9 @@ func updateDynamoDbTimeToLive(tableName string, ttlList []interface{}, conn *dyn
 	return nil
 }
 
-func updateDynamoDbPITR(d *schema.ResourceData, conn *dynamodb.DynamoDB) error {
-	toEnable := d.Get("point_in_time_recovery.0.enabled").(bool)
-
+func updateDynamoDbPITR(tableName string, toEnable bool, conn *dynamodb.DynamoDB) error {
 	input := &dynamodb.UpdateContinuousBackupsInput{
-		TableName: aws.String(d.Id()),
+		TableName: aws.String(tableName),
 		PointInTimeRecoverySpecification: &dynamodb.PointInTimeRecoverySpecification{
 			PointInTimeRecoveryEnabled: aws.Bool(toEnable),
 		},
@@ -1057,36 +1089,209 @@ func updateDynamoDbPITR(d *schema.ResourceData, conn *dynamodb.DynamoDB) error {
 		return fmt.Errorf("error updating DynamoDB PITR status: %w", err)
 	}
 
-	if _, err := waitDynamoDBPITRUpdated(conn, d.Id(), toEnable); err != nil {
+	if _, err := waitDynamoDBPITRUpdated(conn, tableName, toEnable); err != nil {
 		return fmt.Errorf("error waiting for DynamoDB PITR update: %w", err)
 	}
 
 	return nil
 }
 
-func updateDynamoDbReplica(d *schema.ResourceData, conn *dynamodb.DynamoDB) error {
+func updateDynamoDbReplica(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
 	oRaw, nRaw := d.GetChange("replica")
-	o := oRaw.(*schema.Set)
-	n := nRaw.(*schema.Set)
+	o := dynamoDbReplicasByRegion(oRaw.(*schema.Set).List())
+	n := dynamoDbReplicasByRegion(nRaw.(*schema.Set).List())
 
-	removed := o.Difference(n).List()
-	added := n.Difference(o).List()
+	var removed, added []interface{}
 
-	if len(added) > 0 {
-		if err := createDynamoDbReplicas(d.Id(), added, conn); err != nil {
-			return fmt.Errorf("error updating DynamoDB replicas for table (%s), while creating: %w", d.Id(), err)
+	for region, oMap := range o {
+		if nMap, ok := n[region]; !ok || dynamoDbReplicaRequiresReplacement(oMap, nMap) {
+			removed = append(removed, oMap)
+		}
+	}
+
+	for region, nMap := range n {
+		if oMap, ok := o[region]; !ok || dynamoDbReplicaRequiresReplacement(oMap, nMap) {
+			added = append(added, nMap)
+			delete(o, region)
 		}
 	}
 
+	// Replaced replicas are deleted before they are created again.
 	if len(removed) > 0 {
 		if err := deleteDynamoDbReplicas(d.Id(), removed, conn); err != nil {
 			return fmt.Errorf("error updating DynamoDB replicas for table (%s), while deleting: %w", d.Id(), err)
 		}
 	}
 
+	if len(added) > 0 {
+		if err := createDynamoDbReplicas(d.Id(), added, conn); err != nil {
+			return fmt.Errorf("error updating DynamoDB replicas for table (%s), while creating: %w", d.Id(), err)
+		}
+	}
+
+	for region, nMap := range n {
+		if err := updateDynamoDbReplicaSettings(d, d.Get("arn").(string), o[region], nMap, meta); err != nil {
+			return fmt.Errorf("error updating DynamoDB replicas for table (%s): %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// updateDynamoDbReplicaSettings updates the table class, point in time recovery and tags of a replica.
+// oMap is nil for new replicas, which are only created with the table before its ARN is in state.
+func updateDynamoDbReplicaSettings(d *schema.ResourceData, tableARN string, oMap, nMap map[string]interface{}, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+	tableName := d.Id()
+	region := nMap["region_name"].(string)
+
+	if v := nMap["table_class_override"].(string); oMap != nil && v != "" && v != oMap["table_class_override"].(string) {
+		if err := updateDynamoDbReplicaTableClass(tableName, region, v, conn); err != nil {
+			return err
+		}
+	}
+
+	pitr := nMap["point_in_time_recovery"].(bool)
+	updatePITR := (oMap == nil && pitr) || (oMap != nil && pitr != oMap["point_in_time_recovery"].(bool))
+	updateTags := nMap["propagate_tags"].(bool) && (oMap == nil || !oMap["propagate_tags"].(bool) || d.HasChange("tags_all"))
+
+	if !updatePITR && !updateTags {
+		return nil
+	}
+
+	replicaConn, err := dynamoDbReplicaConn(conn, region, meta.(*conns.AWSClient).TerraformVersion)
+
+	if err != nil {
+		return err
+	}
+
+	if updatePITR {
+		if err := updateDynamoDbPITR(tableName, pitr, replicaConn); err != nil {
+			return fmt.Errorf("error updating DynamoDB Table (%s) replica (%s) point in time recovery: %w", tableName, region, err)
+		}
+	}
+
+	if updateTags {
+		replicaARN, err := dynamoDbReplicaARN(tableARN, region)
+
+		if err != nil {
+			return err
+		}
+
+		tags, err := ListTags(replicaConn, replicaARN)
+
+		if err != nil {
+			return fmt.Errorf("error listing tags for DynamoDB Table (%s) replica (%s): %w", tableName, region, err)
+		}
+
+		if err := UpdateTags(replicaConn, replicaARN, tags.Map(), d.Get("tags_all")); err != nil {
+			return fmt.Errorf("error updating DynamoDB Table (%s) replica (%s) tags: %w", tableName, region, err)
+		}
+	}
+
+	return nil
+}
+
+func updateDynamoDbReplicaTableClass(tableName, region, tableClass string, conn *dynamodb.DynamoDB) error {
+	input := &dynamodb.UpdateTableInput{
+		TableName: aws.String(tableName),
+		ReplicaUpdates: []*dynamodb.ReplicationGroupUpdate{
+			{
+				Update: &dynamodb.UpdateReplicationGroupMemberAction{
+					RegionName:         aws.String(region),
+					TableClassOverride: aws.String(tableClass),
+				},
+			},
+		},
+	}
+
+	err := resource.Retry(replicaUpdateTimeout, func() *resource.RetryError {
+		_, err := conn.UpdateTable(input)
+		if err != nil {
+			if tfawserr.ErrCodeEquals(err, "ThrottlingException") {
+				return resource.RetryableError(err)
+			}
+			if tfawserr.ErrMessageContains(err, dynamodb.ErrCodeLimitExceededException, "can be created, updated, or deleted simultaneously") {
+				return resource.RetryableError(err)
+			}
+			if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceInUseException) {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.UpdateTable(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error updating DynamoDB Table (%s) replica (%s) table class: %w", tableName, region, err)
+	}
+
+	if _, err := waitDynamoDBReplicaActive(conn, tableName, region); err != nil {
+		return fmt.Errorf("error waiting for DynamoDB Table (%s) replica (%s) update: %w", tableName, region, err)
+	}
+
 	return nil
 }
 
+// dynamoDbReplicaConn returns a connection to the Region of a replica.
+func dynamoDbReplicaConn(conn *dynamodb.DynamoDB, region, terraformVersion string) (*dynamodb.DynamoDB, error) {
+	session, err := conns.NewSessionForRegion(&conn.Config, region, terraformVersion)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS session for Region (%s): %w", region, err)
+	}
+
+	replicaConn := dynamodb.New(session)
+	// The handlers of the table's connection, e.g. for logging, also apply to its replicas.
+	replicaConn.Handlers = conn.Handlers.Copy()
+
+	return replicaConn, nil
+}
+
+// dynamoDbReplicaARN returns the ARN of a replica, which differs from the ARN of the table only in its Region.
+func dynamoDbReplicaARN(tableARN, region string) (string, error) {
+	v, err := arn.Parse(tableARN)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing DynamoDB Table ARN (%s): %w", tableARN, err)
+	}
+
+	v.Region = region
+
+	return v.String(), nil
+}
+
+func dynamoDbReplicasByRegion(tfList []interface{}) map[string]map[string]interface{} {
+	replicas := make(map[string]map[string]interface{}, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		replicas[tfMap["region_name"].(string)] = tfMap
+	}
+
+	return replicas
+}
+
+// dynamoDbReplicaRequiresReplacement returns whether a replica must be deleted and created again,
+// as its KMS key cannot be updated.
+func dynamoDbReplicaRequiresReplacement(oMap, nMap map[string]interface{}) bool {
+	v := nMap["kms_key_arn"].(string)
+
+	return v != "" && v != oMap["kms_key_arn"].(string)
+}
+
 func UpdateDiffGSI(oldGsi, newGsi []interface{}, billingMode string) (ops []*dynamodb.GlobalSecondaryIndexUpdate, e error) {
 	// Transform slices into maps
 	oldGsis := make(map[string]interface{})
@@ -1449,6 +1654,59 @@ func flattenDynamoDbReplicaDescription(apiObject *dynamodb.ReplicaDescription) m
 
 }
 
+// flattenDynamoDbReplicaSettings flattens replicas with the settings that are managed in the replica Region.
+// propagate_tags cannot be read, and point_in_time_recovery and table_class_override are only read when configured,
+// so that replicas without them configured are neither described in the replica Region nor changed.
+func flattenDynamoDbReplicaSettings(d *schema.ResourceData, apiObjects []*dynamodb.ReplicaDescription, meta interface{}) ([]interface{}, error) {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+	replicas := dynamoDbReplicasByRegion(d.Get("replica").(*schema.Set).List())
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := flattenDynamoDbReplicaDescription(apiObject)
+		region := aws.StringValue(apiObject.RegionName)
+		tfMap["point_in_time_recovery"] = false
+		tfMap["propagate_tags"] = false
+		tfMap["table_class_override"] = ""
+
+		if v, ok := replicas[region]; ok {
+			tfMap["point_in_time_recovery"] = v["point_in_time_recovery"]
+			tfMap["propagate_tags"] = v["propagate_tags"]
+
+			if v["table_class_override"].(string) != "" && apiObject.ReplicaTableClassSummary != nil {
+				tfMap["table_class_override"] = aws.StringValue(apiObject.ReplicaTableClassSummary.TableClass)
+			}
+		}
+
+		if v, ok := replicas[region]; ok && v["point_in_time_recovery"].(bool) && aws.StringValue(apiObject.ReplicaStatus) == dynamodb.ReplicaStatusActive {
+			replicaConn, err := dynamoDbReplicaConn(conn, region, meta.(*conns.AWSClient).TerraformVersion)
+
+			if err != nil {
+				return nil, err
+			}
+
+			output, err := replicaConn.DescribeContinuousBackups(&dynamodb.DescribeContinuousBackupsInput{
+				TableName: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return nil, fmt.Errorf("error describing DynamoDB Table (%s) replica (%s) Continuous Backups: %w", d.Id(), region, err)
+			}
+
+			tfMap["point_in_time_recovery"] = flattenDynamoDbPitr(output)[0].(map[string]interface{})["enabled"]
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList, nil
+}
+
 func flattenDynamoDbReplicaDescriptions(apiObjects []*dynamodb.ReplicaDescription) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
@@ -0,0 +1,353 @@
+package dynamodb_test
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+)
+
+func TestAccDynamoDBTableExport_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v dynamodb.ExportDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_export.test"
+	tableResourceName := "aws_dynamodb_table.test"
+	bucketResourceName := "aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableExportConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableExportExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "dynamodb", regexp.MustCompile(`table/`+rName+`/export/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "end_time"),
+					resource.TestCheckResourceAttr(resourceName, "export_format", "DYNAMODB_JSON"),
+					resource.TestCheckResourceAttr(resourceName, "export_status", "COMPLETED"),
+					resource.TestCheckResourceAttrSet(resourceName, "export_time"),
+					resource.TestCheckResourceAttr(resourceName, "item_count", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest_files_s3_key"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_bucket", bucketResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "s3_sse_algorithm", "AES256"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_arn", tableResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableExport_kms(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v dynamodb.ExportDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_export.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableExportKMSConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableExportExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "export_format", "ION"),
+					resource.TestCheckResourceAttr(resourceName, "export_status", "COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "s3_prefix", "exports/"),
+					resource.TestCheckResourceAttr(resourceName, "s3_sse_algorithm", "KMS"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_sse_kms_key_id", kmsKeyResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestTableExportRead(t *testing.T) {
+	exportARN := "arn:aws:dynamodb:us-west-2:123456789012:table/test/export/01234567890123-abcdefgh" //lintignore:AWSAT003,AWSAT005
+
+	testCases := []struct {
+		Name               string
+		Fake               acctest.UnitTestFake
+		ExpectedAttributes map[string]string
+		ExpectedError      string
+		ExpectedRemoved    bool
+	}{
+		{
+			Name: "found",
+			Fake: func(input interface{}) (interface{}, error) {
+				if v := aws.StringValue(input.(*dynamodb.DescribeExportInput).ExportArn); v != exportARN {
+					return nil, fmt.Errorf("unexpected export ARN: %s", v)
+				}
+
+				return &dynamodb.DescribeExportOutput{
+					ExportDescription: &dynamodb.ExportDescription{
+						EndTime:        aws.Time(time.Date(2022, 6, 1, 12, 30, 0, 0, time.UTC)),
+						ExportArn:      aws.String(exportARN),
+						ExportFormat:   aws.String(dynamodb.ExportFormatIon),
+						ExportManifest: aws.String("AWSDynamoDB/01234567890123-abcdefgh/manifest-summary.json"),
+						ExportStatus:   aws.String(dynamodb.ExportStatusCompleted),
+						ItemCount:      aws.Int64(42),
+						S3Bucket:       aws.String("test"),
+						S3SseAlgorithm: aws.String(dynamodb.S3SseAlgorithmAes256),
+						StartTime:      aws.Time(time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)),
+						TableArn:       aws.String("arn:aws:dynamodb:us-west-2:123456789012:table/test"), //lintignore:AWSAT003,AWSAT005
+					},
+				}, nil
+			},
+			ExpectedAttributes: map[string]string{
+				"arn":                   exportARN,
+				"end_time":              "2022-06-01T12:30:00Z",
+				"export_format":         dynamodb.ExportFormatIon,
+				"export_status":         dynamodb.ExportStatusCompleted,
+				"item_count":            "42",
+				"manifest_files_s3_key": "AWSDynamoDB/01234567890123-abcdefgh/manifest-summary.json",
+				"s3_bucket":             "test",
+				"s3_sse_algorithm":      dynamodb.S3SseAlgorithmAes256,
+				"start_time":            "2022-06-01T12:00:00Z",
+				"table_arn":             "arn:aws:dynamodb:us-west-2:123456789012:table/test", //lintignore:AWSAT003,AWSAT005
+			},
+		},
+		{
+			Name: "not found",
+			Fake: func(input interface{}) (interface{}, error) {
+				return nil, awserr.New(dynamodb.ErrCodeExportNotFoundException, "export not found", nil)
+			},
+			ExpectedRemoved: true,
+		},
+		{
+			Name: "error",
+			Fake: func(input interface{}) (interface{}, error) {
+				return nil, errors.New("access denied")
+			},
+			ExpectedError: "access denied",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := tfdynamodb.ResourceTableExport()
+			meta := acctest.UnitTestClient(t, acctest.UnitTestFakes{
+				"DescribeExport": testCase.Fake,
+			})
+
+			d, err := acctest.UnitTestRead(t, r, acctest.UnitTestState(exportARN, nil), meta)
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if removed := d.Id() == ""; removed != testCase.ExpectedRemoved {
+				t.Fatalf("expected removed from state %t, got %t", testCase.ExpectedRemoved, removed)
+			}
+
+			for k, expected := range testCase.ExpectedAttributes {
+				if got := fmt.Sprint(d.Get(k)); got != expected {
+					t.Errorf("expected %s to be %q, got %q", k, expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestTableExportCreate(t *testing.T) {
+	exportARN := "arn:aws:dynamodb:us-west-2:123456789012:table/test/export/01234567890123-abcdefgh" //lintignore:AWSAT003,AWSAT005
+	describeExportCalls := 0
+
+	r := tfdynamodb.ResourceTableExport()
+	meta := acctest.UnitTestClient(t, acctest.UnitTestFakes{
+		"ExportTableToPointInTime": func(input interface{}) (interface{}, error) {
+			return &dynamodb.ExportTableToPointInTimeOutput{
+				ExportDescription: &dynamodb.ExportDescription{
+					ExportArn:    aws.String(exportARN),
+					ExportStatus: aws.String(dynamodb.ExportStatusInProgress),
+				},
+			}, nil
+		},
+		"DescribeExport": func(input interface{}) (interface{}, error) {
+			describeExportCalls++
+			status := dynamodb.ExportStatusInProgress
+
+			if describeExportCalls > 2 {
+				status = dynamodb.ExportStatusCompleted
+			}
+
+			return &dynamodb.DescribeExportOutput{
+				ExportDescription: &dynamodb.ExportDescription{
+					ExportArn:    aws.String(exportARN),
+					ExportStatus: aws.String(status),
+					S3Bucket:     aws.String("test"),
+					TableArn:     aws.String("arn:aws:dynamodb:us-west-2:123456789012:table/test"), //lintignore:AWSAT003,AWSAT005
+				},
+			}, nil
+		},
+	})
+
+	d, err := acctest.UnitTestCreate(t, r, map[string]interface{}{
+		"s3_bucket": "test",
+		"table_arn": "arn:aws:dynamodb:us-west-2:123456789012:table/test", //lintignore:AWSAT003,AWSAT005
+	}, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := d.Id(); got != exportARN {
+		t.Errorf("expected ID %q, got %q", exportARN, got)
+	}
+
+	if got := d.Get("export_status").(string); got != dynamodb.ExportStatusCompleted {
+		t.Errorf("expected export_status %q, got %q", dynamodb.ExportStatusCompleted, got)
+	}
+}
+
+func TestTableExportCreate_error(t *testing.T) {
+	r := tfdynamodb.ResourceTableExport()
+	meta := acctest.UnitTestClient(t, acctest.UnitTestFakes{
+		"ExportTableToPointInTime": func(input interface{}) (interface{}, error) {
+			if v := aws.StringValue(input.(*dynamodb.ExportTableToPointInTimeInput).S3Prefix); v != "exports/" {
+				return nil, fmt.Errorf("unexpected S3 prefix: %s", v)
+			}
+
+			return nil, awserr.New(dynamodb.ErrCodePointInTimeRecoveryUnavailableException, "point in time recovery is not enabled", nil)
+		},
+	})
+
+	_, err := acctest.UnitTestCreate(t, r, map[string]interface{}{
+		"s3_bucket": "test",
+		"s3_prefix": "exports/",
+		"table_arn": "arn:aws:dynamodb:us-west-2:123456789012:table/test", //lintignore:AWSAT003,AWSAT005
+	}, meta)
+
+	if err == nil || !strings.Contains(err.Error(), dynamodb.ErrCodePointInTimeRecoveryUnavailableException) {
+		t.Fatalf("expected error containing %q, got %v", dynamodb.ErrCodePointInTimeRecoveryUnavailableException, err)
+	}
+}
+
+func TestTableExportDelete(t *testing.T) {
+	r := tfdynamodb.ResourceTableExport()
+	meta := acctest.UnitTestClient(t, nil)
+
+	if err := acctest.UnitTestDelete(t, r, acctest.UnitTestState("arn:aws:dynamodb:us-west-2:123456789012:table/test/export/01234567890123-abcdefgh", nil), meta); err != nil { //lintignore:AWSAT003,AWSAT005
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func testAccCheckTableExportExists(n string, v *dynamodb.ExportDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DynamoDB Table Export ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+		output, err := tfdynamodb.FindTableExportByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTableExportBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  point_in_time_recovery {
+    enabled = true
+  }
+}
+`, rName)
+}
+
+func testAccTableExportConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTableExportBaseConfig(rName), `
+resource "aws_dynamodb_table_export" "test" {
+  table_arn = aws_dynamodb_table.test.arn
+  s3_bucket = aws_s3_bucket.test.id
+}
+`)
+}
+
+func testAccTableExportKMSConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTableExportBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_dynamodb_table_export" "test" {
+  table_arn         = aws_dynamodb_table.test.arn
+  s3_bucket         = aws_s3_bucket.test.id
+  s3_prefix         = "exports/"
+  s3_sse_algorithm  = "KMS"
+  s3_sse_kms_key_id = aws_kms_key.test.id
+  export_format     = "ION"
+}
+`, rName))
+}
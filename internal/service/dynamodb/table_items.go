@@ -0,0 +1,887 @@
+package dynamodb
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Limits on the number of items in a single BatchGetItem and BatchWriteItem request.
+const (
+	tableItemsBatchGetMaxKeys    = 100
+	tableItemsBatchWriteMaxItems = 25
+)
+
+const (
+	tableItemsCSVAttributeTypeBool = "BOOL"
+)
+
+func ResourceTableItems() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTableItemsCreate,
+		Read:   resourceTableItemsRead,
+		Update: resourceTableItemsUpdate,
+		Delete: resourceTableItemsDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceTableItemsImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(tableItemsTimeout),
+			Update: schema.DefaultTimeout(tableItemsTimeout),
+			Delete: schema.DefaultTimeout(tableItemsTimeout),
+		},
+
+		CustomizeDiff: resourceTableItemsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"csv_attribute_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						dynamodb.ScalarAttributeTypeB,
+						tableItemsCSVAttributeTypeBool,
+						dynamodb.ScalarAttributeTypeN,
+						dynamodb.ScalarAttributeTypeS,
+					}, false),
+				},
+			},
+			"csv_delimiter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ",",
+				ValidateFunc: validation.StringLenBetween(1, 1),
+			},
+			"format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  dynamodb.InputFormatDynamodbJson,
+				ValidateFunc: validation.StringInSlice([]string{
+					dynamodb.InputFormatCsv,
+					dynamodb.InputFormatDynamodbJson,
+				}, false),
+			},
+			"hash_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"item_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"item_hashes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"range_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTableItemsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName := d.Get("table_name").(string)
+	items, err := expandTableItemsConfig(d.Get)
+
+	if err != nil {
+		return err
+	}
+
+	hashes, err := tableItemsHashes(items)
+
+	if err != nil {
+		return err
+	}
+
+	requests, err := diffTableItems(nil, items, hashes)
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Writing %d DynamoDB Table (%s) items", len(requests), tableName)
+	if err := batchWriteTableItems(conn.BatchWriteItem, tableName, requests, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error writing DynamoDB Table (%s) items: %w", tableName, err)
+	}
+
+	d.SetId(TableItemsCreateResourceID(tableName))
+	d.Set("item_hashes", hashes)
+
+	return resourceTableItemsRead(d, meta)
+}
+
+func resourceTableItemsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName := d.Get("table_name").(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	// Items are matched to their key IDs by the hashes of their keys, as DynamoDB normalizes numbers.
+	var keys []map[string]*dynamodb.AttributeValue
+	keyIDs := map[string]string{}
+
+	for id := range d.Get("item_hashes").(map[string]interface{}) {
+		key, err := ExpandTableItemAttributes(id)
+
+		if err != nil {
+			return fmt.Errorf("error reading DynamoDB Table (%s) item key (%s): %w", tableName, id, err)
+		}
+
+		hash, err := tableItemHash(key)
+
+		if err != nil {
+			return err
+		}
+
+		keys = append(keys, key)
+		keyIDs[hash] = id
+	}
+
+	items, err := batchGetTableItems(conn.BatchGetItem, tableName, keys, tableItemsTimeout)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] DynamoDB Table (%s) not found, removing items (%s) from state", tableName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Table (%s) items: %w", tableName, err)
+	}
+
+	hashes := map[string]string{}
+
+	for _, item := range items {
+		keyHash, err := tableItemHash(BuildTableItemqueryKey(item, hashKey, rangeKey))
+
+		if err != nil {
+			return err
+		}
+
+		id, ok := keyIDs[keyHash]
+
+		if !ok {
+			continue
+		}
+
+		hashes[id], err = tableItemHash(item)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	d.Set("item_count", len(hashes))
+	d.Set("item_hashes", hashes)
+	d.Set("table_name", tableName)
+
+	return nil
+}
+
+func resourceTableItemsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName := d.Get("table_name").(string)
+	items, err := expandTableItemsConfig(d.Get)
+
+	if err != nil {
+		return err
+	}
+
+	hashes, err := tableItemsHashes(items)
+
+	if err != nil {
+		return err
+	}
+
+	o, _ := d.GetChange("item_hashes")
+	requests, err := diffTableItems(aws.StringValueMap(flex.ExpandStringMap(o.(map[string]interface{}))), items, hashes)
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Writing %d DynamoDB Table (%s) items", len(requests), tableName)
+	if err := batchWriteTableItems(conn.BatchWriteItem, tableName, requests, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error writing DynamoDB Table (%s) items: %w", tableName, err)
+	}
+
+	d.Set("item_hashes", hashes)
+
+	return resourceTableItemsRead(d, meta)
+}
+
+func resourceTableItemsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName := d.Get("table_name").(string)
+	requests, err := diffTableItems(aws.StringValueMap(flex.ExpandStringMap(d.Get("item_hashes").(map[string]interface{}))), nil, nil)
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting %d DynamoDB Table (%s) items", len(requests), tableName)
+	err = batchWriteTableItems(conn.BatchWriteItem, tableName, requests, d.Timeout(schema.TimeoutDelete))
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting DynamoDB Table (%s) items: %w", tableName, err)
+	}
+
+	return nil
+}
+
+// resourceTableItemsImport imports a table's items resource without items, which are written again on the next apply.
+// The ID is either a table name or a resource ID, i.e. the table name and a unique ID separated by a comma (,).
+func resourceTableItemsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName, _, err := TableItemsParseResourceID(d.Id())
+
+	if err != nil {
+		tableName = d.Id()
+		d.SetId(TableItemsCreateResourceID(tableName))
+	}
+
+	table, err := FindDynamoDBTableByName(conn, tableName)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading DynamoDB Table (%s): %w", tableName, err)
+	}
+
+	for _, v := range table.KeySchema {
+		switch aws.StringValue(v.KeyType) {
+		case dynamodb.KeyTypeHash:
+			d.Set("hash_key", v.AttributeName)
+		case dynamodb.KeyTypeRange:
+			d.Set("range_key", v.AttributeName)
+		}
+	}
+
+	d.Set("csv_delimiter", ",")
+	d.Set("format", dynamodb.InputFormatDynamodbJson)
+	d.Set("table_name", tableName)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceTableItemsCustomizeDiff plans the hashes of the configured items,
+// so that changed and drifted items cause an update.
+func resourceTableItemsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, k := range []string{"content", "csv_attribute_types", "csv_delimiter", "format", "hash_key", "range_key"} {
+		if !diff.NewValueKnown(k) {
+			if err := diff.SetNewComputed("item_count"); err != nil {
+				return err
+			}
+
+			return diff.SetNewComputed("item_hashes")
+		}
+	}
+
+	items, err := expandTableItemsConfig(diff.Get)
+
+	if err != nil {
+		return err
+	}
+
+	hashes, err := tableItemsHashes(items)
+
+	if err != nil {
+		return err
+	}
+
+	if reflect.DeepEqual(aws.StringValueMap(flex.ExpandStringMap(diff.Get("item_hashes").(map[string]interface{}))), hashes) {
+		return nil
+	}
+
+	if err := diff.SetNew("item_count", len(hashes)); err != nil {
+		return err
+	}
+
+	return diff.SetNew("item_hashes", hashes)
+}
+
+// expandTableItemsConfig returns the configured items by key ID.
+func expandTableItemsConfig(get func(string) interface{}) (map[string]map[string]*dynamodb.AttributeValue, error) {
+	return expandTableItems(
+		get("content").(string),
+		get("format").(string),
+		get("csv_delimiter").(string),
+		aws.StringValueMap(flex.ExpandStringMap(get("csv_attribute_types").(map[string]interface{}))),
+		get("hash_key").(string),
+		get("range_key").(string),
+	)
+}
+
+// expandTableItems parses the items in content and returns them by key ID.
+func expandTableItems(content, format, delimiter string, attributeTypes map[string]string, hashKey, rangeKey string) (map[string]map[string]*dynamodb.AttributeValue, error) {
+	var items []map[string]*dynamodb.AttributeValue
+	var err error
+
+	switch format {
+	case dynamodb.InputFormatCsv:
+		items, err = expandTableItemsCSV(content, delimiter, attributeTypes)
+	default:
+		items, err = expandTableItemsDynamoDBJSON(content)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]map[string]*dynamodb.AttributeValue, len(items))
+	keyHashes := make(map[string]struct{}, len(items))
+
+	for i, item := range items {
+		for _, k := range []string{hashKey, rangeKey} {
+			if k == "" {
+				continue
+			}
+
+			if _, ok := item[k]; !ok {
+				return nil, fmt.Errorf("item %d is missing key attribute (%s)", i+1, k)
+			}
+		}
+
+		key := BuildTableItemqueryKey(item, hashKey, rangeKey)
+		id, err := tableItemKeyID(key)
+
+		if err != nil {
+			return nil, err
+		}
+
+		keyHash, err := tableItemHash(key)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := keyHashes[keyHash]; ok {
+			return nil, fmt.Errorf("item %d has duplicate key %s", i+1, id)
+		}
+
+		keyHashes[keyHash] = struct{}{}
+		result[id] = item
+	}
+
+	return result, nil
+}
+
+// expandTableItemsDynamoDBJSON parses a JSON array or a stream of items in DynamoDB JSON format.
+// Items may be wrapped in an "Item" object, as in DynamoDB exports to Amazon S3.
+func expandTableItemsDynamoDBJSON(content string) ([]map[string]*dynamodb.AttributeValue, error) {
+	var raws []json.RawMessage
+	dec := json.NewDecoder(strings.NewReader(content))
+
+	if strings.HasPrefix(strings.TrimSpace(content), "[") {
+		if err := dec.Decode(&raws); err != nil {
+			return nil, fmt.Errorf("error decoding items: %w", err)
+		}
+	} else {
+		for {
+			var raw json.RawMessage
+
+			if err := dec.Decode(&raw); err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, fmt.Errorf("error decoding item %d: %w", len(raws)+1, err)
+			}
+
+			raws = append(raws, raw)
+		}
+	}
+
+	items := make([]map[string]*dynamodb.AttributeValue, 0, len(raws))
+
+	for i, raw := range raws {
+		var wrapper map[string]json.RawMessage
+
+		if err := json.Unmarshal(raw, &wrapper); err != nil {
+			return nil, fmt.Errorf("error decoding item %d: %w", i+1, err)
+		}
+
+		if v, ok := wrapper["Item"]; ok && len(wrapper) == 1 {
+			var attributes map[string]json.RawMessage
+
+			if err := json.Unmarshal(v, &attributes); err == nil && !isTableItemAttributeValue(attributes) {
+				raw = v
+			}
+		}
+
+		item, err := ExpandTableItemAttributes(string(raw))
+
+		if err != nil {
+			return nil, fmt.Errorf("error decoding item %d: %w", i+1, err)
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// isTableItemAttributeValue returns whether v is an attribute value in DynamoDB JSON format,
+// consisting of a single data type descriptor.
+func isTableItemAttributeValue(v map[string]json.RawMessage) bool {
+	if len(v) != 1 {
+		return false
+	}
+
+	for k := range v {
+		switch k {
+		case "B", "BOOL", "BS", "L", "M", "N", "NS", "NULL", "S", "SS":
+			return true
+		}
+	}
+
+	return false
+}
+
+// expandTableItemsCSV parses CSV content with a header row of attribute names.
+// Attributes are strings unless configured otherwise in attributeTypes. Empty values are omitted.
+func expandTableItemsCSV(content, delimiter string, attributeTypes map[string]string) ([]map[string]*dynamodb.AttributeValue, error) {
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.Comma, _ = utf8.DecodeRuneInString(delimiter)
+
+	records, err := reader.ReadAll()
+
+	if err != nil {
+		return nil, fmt.Errorf("error decoding CSV: %w", err)
+	}
+
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	header := records[0]
+	items := make([]map[string]*dynamodb.AttributeValue, 0, len(records)-1)
+
+	for i, record := range records[1:] {
+		item := map[string]*dynamodb.AttributeValue{}
+
+		for j, v := range record {
+			if v == "" {
+				continue
+			}
+
+			name := header[j]
+			value, err := expandTableItemCSVValue(v, attributeTypes[name])
+
+			if err != nil {
+				return nil, fmt.Errorf("error decoding item %d attribute (%s): %w", i+1, name, err)
+			}
+
+			item[name] = value
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func expandTableItemCSVValue(v, attributeType string) (*dynamodb.AttributeValue, error) {
+	switch attributeType {
+	case dynamodb.ScalarAttributeTypeB:
+		b, err := base64.StdEncoding.DecodeString(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &dynamodb.AttributeValue{B: b}, nil
+	case tableItemsCSVAttributeTypeBool:
+		b, err := strconv.ParseBool(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &dynamodb.AttributeValue{BOOL: aws.Bool(b)}, nil
+	case dynamodb.ScalarAttributeTypeN:
+		if _, ok := new(big.Rat).SetString(v); !ok {
+			return nil, fmt.Errorf("invalid number: %s", v)
+		}
+
+		return &dynamodb.AttributeValue{N: aws.String(v)}, nil
+	default:
+		return &dynamodb.AttributeValue{S: aws.String(v)}, nil
+	}
+}
+
+const tableItemsResourceIDSeparator = ","
+
+// TableItemsCreateResourceID returns a unique resource ID, so that several resources can manage the items of a table.
+func TableItemsCreateResourceID(tableName string) string {
+	parts := []string{tableName, resource.UniqueId()}
+	resourceID := strings.Join(parts, tableItemsResourceIDSeparator)
+
+	return resourceID
+}
+
+func TableItemsParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, tableItemsResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TABLE_NAME%[2]sUNIQUE_ID", id, tableItemsResourceIDSeparator)
+}
+
+// tableItemKeyID returns the DynamoDB JSON of an item key, which identifies the item in item_hashes.
+func tableItemKeyID(key map[string]*dynamodb.AttributeValue) (string, error) {
+	v, err := flattenDynamoDBTableItemAttributes(key)
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(v), nil
+}
+
+// tableItemKeyIDHash returns the hash of the item key identified by a key ID.
+func tableItemKeyIDHash(id string) (string, error) {
+	key, err := ExpandTableItemAttributes(id)
+
+	if err != nil {
+		return "", fmt.Errorf("error decoding item key (%s): %w", id, err)
+	}
+
+	return tableItemHash(key)
+}
+
+func tableItemsHashes(items map[string]map[string]*dynamodb.AttributeValue) (map[string]string, error) {
+	hashes := make(map[string]string, len(items))
+
+	for id, item := range items {
+		hash, err := tableItemHash(item)
+
+		if err != nil {
+			return nil, err
+		}
+
+		hashes[id] = hash
+	}
+
+	return hashes, nil
+}
+
+// tableItemHash returns a hash of an item that is independent of the order of set elements
+// and of the representation of numbers.
+func tableItemHash(item map[string]*dynamodb.AttributeValue) (string, error) {
+	v, err := json.Marshal(normalizeTableItemAttributes(item))
+
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(v)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+func normalizeTableItemAttributes(item map[string]*dynamodb.AttributeValue) map[string]*dynamodb.AttributeValue {
+	result := make(map[string]*dynamodb.AttributeValue, len(item))
+
+	for k, v := range item {
+		result[k] = normalizeTableItemAttributeValue(v)
+	}
+
+	return result
+}
+
+func normalizeTableItemAttributeValue(v *dynamodb.AttributeValue) *dynamodb.AttributeValue {
+	if v == nil {
+		return nil
+	}
+
+	result := &dynamodb.AttributeValue{
+		B:    v.B,
+		BOOL: v.BOOL,
+		NULL: v.NULL,
+		S:    v.S,
+	}
+
+	if v.BS != nil {
+		result.BS = make([][]byte, len(v.BS))
+		copy(result.BS, v.BS)
+		sort.Slice(result.BS, func(i, j int) bool { return bytes.Compare(result.BS[i], result.BS[j]) < 0 })
+	}
+
+	if v.L != nil {
+		result.L = make([]*dynamodb.AttributeValue, len(v.L))
+
+		for i, v := range v.L {
+			result.L[i] = normalizeTableItemAttributeValue(v)
+		}
+	}
+
+	if v.M != nil {
+		result.M = normalizeTableItemAttributes(v.M)
+	}
+
+	if v.N != nil {
+		result.N = aws.String(normalizeTableItemNumber(aws.StringValue(v.N)))
+	}
+
+	if v.NS != nil {
+		ns := make([]string, len(v.NS))
+
+		for i, v := range v.NS {
+			ns[i] = normalizeTableItemNumber(aws.StringValue(v))
+		}
+
+		sort.Strings(ns)
+		result.NS = aws.StringSlice(ns)
+	}
+
+	if v.SS != nil {
+		ss := aws.StringValueSlice(v.SS)
+		sort.Strings(ss)
+		result.SS = aws.StringSlice(ss)
+	}
+
+	return result
+}
+
+// normalizeTableItemNumber returns an exact representation of a number that DynamoDB considers equal,
+// e.g. "1.50" and "1.5".
+func normalizeTableItemNumber(v string) string {
+	r, ok := new(big.Rat).SetString(v)
+
+	if !ok {
+		return v
+	}
+
+	return r.RatString()
+}
+
+// diffTableItems returns the write requests that put the items whose hashes differ from the old hashes
+// and delete the items whose keys are no longer present.
+// Old and new items are matched by the hashes of their keys, as key IDs differ if number representations change.
+func diffTableItems(oldHashes map[string]string, items map[string]map[string]*dynamodb.AttributeValue, hashes map[string]string) ([]*dynamodb.WriteRequest, error) {
+	var puts, deletes []string
+
+	ids := make(map[string]string, len(items))
+
+	for id := range items {
+		keyHash, err := tableItemKeyIDHash(id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		ids[keyHash] = id
+	}
+
+	matchedHashes := make(map[string]string, len(oldHashes))
+
+	for oldID, oldHash := range oldHashes {
+		keyHash, err := tableItemKeyIDHash(oldID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if id, ok := ids[keyHash]; ok {
+			matchedHashes[id] = oldHash
+		} else {
+			deletes = append(deletes, oldID)
+		}
+	}
+
+	for id := range items {
+		if matchedHashes[id] != hashes[id] {
+			puts = append(puts, id)
+		}
+	}
+
+	sort.Strings(puts)
+	sort.Strings(deletes)
+
+	requests := make([]*dynamodb.WriteRequest, 0, len(puts)+len(deletes))
+
+	for _, id := range puts {
+		requests = append(requests, &dynamodb.WriteRequest{
+			PutRequest: &dynamodb.PutRequest{
+				Item: items[id],
+			},
+		})
+	}
+
+	for _, id := range deletes {
+		key, err := ExpandTableItemAttributes(id)
+
+		if err != nil {
+			return nil, fmt.Errorf("error decoding item key (%s): %w", id, err)
+		}
+
+		requests = append(requests, &dynamodb.WriteRequest{
+			DeleteRequest: &dynamodb.DeleteRequest{
+				Key: key,
+			},
+		})
+	}
+
+	return requests, nil
+}
+
+// batchWriteTableItems writes requests in batches, retrying unprocessed items until timeout.
+func batchWriteTableItems(write func(*dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error), tableName string, requests []*dynamodb.WriteRequest, timeout time.Duration) error {
+	for start := 0; start < len(requests); start += tableItemsBatchWriteMaxItems {
+		end := start + tableItemsBatchWriteMaxItems
+
+		if end > len(requests) {
+			end = len(requests)
+		}
+
+		pending := map[string][]*dynamodb.WriteRequest{tableName: requests[start:end]}
+		err := retryTableItemsBatch(timeout, func() (int, error) {
+			output, err := write(&dynamodb.BatchWriteItemInput{
+				RequestItems: pending,
+			})
+
+			if err != nil {
+				return 0, err
+			}
+
+			pending = output.UnprocessedItems
+
+			return len(pending[tableName]), nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// batchGetTableItems reads the items with the specified keys in batches, retrying unprocessed keys until timeout.
+// Items that do not exist are not returned.
+func batchGetTableItems(get func(*dynamodb.BatchGetItemInput) (*dynamodb.BatchGetItemOutput, error), tableName string, keys []map[string]*dynamodb.AttributeValue, timeout time.Duration) ([]map[string]*dynamodb.AttributeValue, error) {
+	var items []map[string]*dynamodb.AttributeValue
+
+	for start := 0; start < len(keys); start += tableItemsBatchGetMaxKeys {
+		end := start + tableItemsBatchGetMaxKeys
+
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		pending := map[string]*dynamodb.KeysAndAttributes{
+			tableName: {
+				ConsistentRead: aws.Bool(true),
+				Keys:           keys[start:end],
+			},
+		}
+		err := retryTableItemsBatch(timeout, func() (int, error) {
+			output, err := get(&dynamodb.BatchGetItemInput{
+				RequestItems: pending,
+			})
+
+			if err != nil {
+				return 0, err
+			}
+
+			items = append(items, output.Responses[tableName]...)
+			pending = output.UnprocessedKeys
+
+			if v := pending[tableName]; v != nil {
+				return len(v.Keys), nil
+			}
+
+			return 0, nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return items, nil
+}
+
+// retryTableItemsBatch calls f until it returns no unprocessed items or keys, retrying throttling errors.
+func retryTableItemsBatch(timeout time.Duration, f func() (int, error)) error {
+	var unprocessed int
+
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		var err error
+		unprocessed, err = f()
+
+		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeProvisionedThroughputExceededException, dynamodb.ErrCodeRequestLimitExceeded) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if unprocessed > 0 {
+			return resource.RetryableError(fmt.Errorf("%d requests unprocessed", unprocessed))
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		unprocessed, err = f()
+	}
+
+	if err == nil && unprocessed > 0 {
+		err = fmt.Errorf("%d requests unprocessed", unprocessed)
+	}
+
+	return err
+}
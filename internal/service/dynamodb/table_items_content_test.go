@@ -0,0 +1,342 @@
+package dynamodb
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+func TestExpandTableItems(t *testing.T) {
+	expected := map[string]map[string]*dynamodb.AttributeValue{
+		`{"pk":{"S":"a"},"sk":{"N":"1"}}`: {
+			"pk":    {S: aws.String("a")},
+			"sk":    {N: aws.String("1")},
+			"value": {S: aws.String("one")},
+		},
+		`{"pk":{"S":"a"},"sk":{"N":"2"}}`: {
+			"pk":      {S: aws.String("a")},
+			"sk":      {N: aws.String("2")},
+			"enabled": {BOOL: aws.Bool(true)},
+		},
+	}
+
+	testCases := []struct {
+		Name           string
+		Content        string
+		Format         string
+		Delimiter      string
+		AttributeTypes map[string]string
+	}{
+		{
+			Name: "array",
+			Content: `[
+  {"pk": {"S": "a"}, "sk": {"N": "1"}, "value": {"S": "one"}},
+  {"pk": {"S": "a"}, "sk": {"N": "2"}, "enabled": {"BOOL": true}}
+]`,
+			Format: dynamodb.InputFormatDynamodbJson,
+		},
+		{
+			Name: "stream",
+			Content: `{"pk": {"S": "a"}, "sk": {"N": "1"}, "value": {"S": "one"}}
+{"pk": {"S": "a"}, "sk": {"N": "2"}, "enabled": {"BOOL": true}}
+`,
+			Format: dynamodb.InputFormatDynamodbJson,
+		},
+		{
+			Name: "export",
+			Content: `{"Item": {"pk": {"S": "a"}, "sk": {"N": "1"}, "value": {"S": "one"}}}
+{"Item": {"pk": {"S": "a"}, "sk": {"N": "2"}, "enabled": {"BOOL": true}}}
+`,
+			Format: dynamodb.InputFormatDynamodbJson,
+		},
+		{
+			Name: "csv",
+			Content: `pk,sk,value,enabled
+a,1,one,
+a,2,,true
+`,
+			Format:         dynamodb.InputFormatCsv,
+			Delimiter:      ",",
+			AttributeTypes: map[string]string{"enabled": "BOOL", "sk": "N"},
+		},
+		{
+			Name:           "csv delimiter",
+			Content:        "pk\tsk\tvalue\tenabled\na\t1\tone\t\na\t2\t\ttrue\n",
+			Format:         dynamodb.InputFormatCsv,
+			Delimiter:      "\t",
+			AttributeTypes: map[string]string{"enabled": "BOOL", "sk": "N"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := expandTableItems(testCase.Content, testCase.Format, testCase.Delimiter, testCase.AttributeTypes, "pk", "sk")
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, expected) {
+				t.Errorf("expected %v, got %v", expected, got)
+			}
+		})
+	}
+}
+
+func TestExpandTableItemsItemAttribute(t *testing.T) {
+	got, err := expandTableItems(`{"Item": {"S": "a"}}`, dynamodb.InputFormatDynamodbJson, ",", nil, "Item", "")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]map[string]*dynamodb.AttributeValue{
+		`{"Item":{"S":"a"}}`: {
+			"Item": {S: aws.String("a")},
+		},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestExpandTableItemsErrors(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		Content        string
+		Format         string
+		AttributeTypes map[string]string
+		Expected       string
+	}{
+		{
+			Name:     "missing key",
+			Content:  `{"pk": {"S": "a"}, "sk": {"N": "1"}} {"pk": {"S": "b"}}`,
+			Format:   dynamodb.InputFormatDynamodbJson,
+			Expected: "item 2 is missing key attribute (sk)",
+		},
+		{
+			Name:     "duplicate key",
+			Content:  `[{"pk": {"S": "a"}, "sk": {"N": "1.0"}}, {"pk": {"S": "a"}, "sk": {"N": "1"}}]`,
+			Format:   dynamodb.InputFormatDynamodbJson,
+			Expected: `item 2 has duplicate key {"pk":{"S":"a"},"sk":{"N":"1"}}`,
+		},
+		{
+			Name:           "csv number",
+			Content:        "pk,sk\na,one\n",
+			Format:         dynamodb.InputFormatCsv,
+			AttributeTypes: map[string]string{"sk": "N"},
+			Expected:       "error decoding item 1 attribute (sk): invalid number: one",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := expandTableItems(testCase.Content, testCase.Format, ",", testCase.AttributeTypes, "pk", "sk")
+
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+
+			if err.Error() != testCase.Expected {
+				t.Errorf("expected error %q, got %q", testCase.Expected, err)
+			}
+		})
+	}
+}
+
+func TestTableItemHash(t *testing.T) {
+	hash := func(item map[string]*dynamodb.AttributeValue) string {
+		v, err := tableItemHash(item)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return v
+	}
+
+	item := map[string]*dynamodb.AttributeValue{
+		"n":  {N: aws.String("1.50")},
+		"ns": {NS: aws.StringSlice([]string{"2", "10"})},
+		"m": {M: map[string]*dynamodb.AttributeValue{
+			"ss": {SS: aws.StringSlice([]string{"b", "a"})},
+		}},
+	}
+	normalized := map[string]*dynamodb.AttributeValue{
+		"n":  {N: aws.String("1.5")},
+		"ns": {NS: aws.StringSlice([]string{"10", "2.0"})},
+		"m": {M: map[string]*dynamodb.AttributeValue{
+			"ss": {SS: aws.StringSlice([]string{"a", "b"})},
+		}},
+	}
+	different := map[string]*dynamodb.AttributeValue{
+		"n":  {N: aws.String("1.51")},
+		"ns": {NS: aws.StringSlice([]string{"2", "10"})},
+		"m": {M: map[string]*dynamodb.AttributeValue{
+			"ss": {SS: aws.StringSlice([]string{"b", "a"})},
+		}},
+	}
+
+	if hash(item) != hash(normalized) {
+		t.Error("expected equal hashes for equal items")
+	}
+
+	if hash(item) == hash(different) {
+		t.Error("expected different hashes for different items")
+	}
+}
+
+func TestDiffTableItems(t *testing.T) {
+	items := map[string]map[string]*dynamodb.AttributeValue{
+		`{"pk":{"S":"a"}}`:    {"pk": {S: aws.String("a")}, "v": {S: aws.String("1")}},
+		`{"pk":{"S":"b"}}`:    {"pk": {S: aws.String("b")}, "v": {S: aws.String("2")}},
+		`{"pk":{"S":"c"}}`:    {"pk": {S: aws.String("c")}, "v": {S: aws.String("3")}},
+		`{"pk":{"N":"1.50"}}`: {"pk": {N: aws.String("1.50")}, "v": {S: aws.String("4")}},
+	}
+	hashes := map[string]string{
+		`{"pk":{"S":"a"}}`:    "a1",
+		`{"pk":{"S":"b"}}`:    "b2",
+		`{"pk":{"S":"c"}}`:    "c3",
+		`{"pk":{"N":"1.50"}}`: "n4",
+	}
+	oldHashes := map[string]string{
+		`{"pk":{"S":"a"}}`:   "a1",
+		`{"pk":{"S":"b"}}`:   "b1",
+		`{"pk":{"S":"d"}}`:   "d1",
+		`{"pk":{"N":"1.5"}}`: "n4",
+	}
+
+	got, err := diffTableItems(oldHashes, items, hashes)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []*dynamodb.WriteRequest{
+		{PutRequest: &dynamodb.PutRequest{Item: items[`{"pk":{"S":"b"}}`]}},
+		{PutRequest: &dynamodb.PutRequest{Item: items[`{"pk":{"S":"c"}}`]}},
+		{DeleteRequest: &dynamodb.DeleteRequest{Key: map[string]*dynamodb.AttributeValue{"pk": {S: aws.String("d")}}}},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestBatchWriteTableItems(t *testing.T) {
+	var requests []*dynamodb.WriteRequest
+
+	for i := 0; i < 30; i++ {
+		requests = append(requests, &dynamodb.WriteRequest{
+			PutRequest: &dynamodb.PutRequest{
+				Item: map[string]*dynamodb.AttributeValue{"pk": {N: aws.String(strconv.Itoa(i))}},
+			},
+		})
+	}
+
+	var calls, written int
+	throttled := false
+
+	write := func(input *dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error) {
+		calls++
+		batch := input.RequestItems["test"]
+
+		if len(batch) > tableItemsBatchWriteMaxItems {
+			t.Fatalf("batch of %d requests exceeds limit", len(batch))
+		}
+
+		if !throttled {
+			throttled = true
+
+			return nil, awserr.New(dynamodb.ErrCodeProvisionedThroughputExceededException, "throttled", nil)
+		}
+
+		// Process all but the last two requests of full batches.
+		if len(batch) == tableItemsBatchWriteMaxItems {
+			written += len(batch) - 2
+
+			return &dynamodb.BatchWriteItemOutput{
+				UnprocessedItems: map[string][]*dynamodb.WriteRequest{"test": batch[len(batch)-2:]},
+			}, nil
+		}
+
+		written += len(batch)
+
+		return &dynamodb.BatchWriteItemOutput{}, nil
+	}
+
+	if err := batchWriteTableItems(write, "test", requests, 1*time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if written != len(requests) {
+		t.Errorf("expected %d requests written, got %d", len(requests), written)
+	}
+
+	if expected := 4; calls != expected {
+		t.Errorf("expected %d calls, got %d", expected, calls)
+	}
+}
+
+func TestBatchGetTableItems(t *testing.T) {
+	keys := []map[string]*dynamodb.AttributeValue{
+		{"pk": {S: aws.String("a")}},
+		{"pk": {S: aws.String("b")}},
+		{"pk": {S: aws.String("c")}},
+	}
+
+	var calls int
+
+	get := func(input *dynamodb.BatchGetItemInput) (*dynamodb.BatchGetItemOutput, error) {
+		calls++
+		v := input.RequestItems["test"]
+
+		if !aws.BoolValue(v.ConsistentRead) {
+			t.Error("expected consistent read")
+		}
+
+		// Item "b" does not exist and item "c" is unprocessed by the first call.
+		output := &dynamodb.BatchGetItemOutput{
+			Responses: map[string][]map[string]*dynamodb.AttributeValue{},
+		}
+
+		for _, key := range v.Keys {
+			switch aws.StringValue(key["pk"].S) {
+			case "a":
+				output.Responses["test"] = append(output.Responses["test"], key)
+			case "c":
+				if calls == 1 {
+					output.UnprocessedKeys = map[string]*dynamodb.KeysAndAttributes{
+						"test": {ConsistentRead: aws.Bool(true), Keys: []map[string]*dynamodb.AttributeValue{key}},
+					}
+				} else {
+					output.Responses["test"] = append(output.Responses["test"], key)
+				}
+			}
+		}
+
+		return output, nil
+	}
+
+	got, err := batchGetTableItems(get, "test", keys, 1*time.Minute)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []map[string]*dynamodb.AttributeValue{keys[0], keys[2]}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+}
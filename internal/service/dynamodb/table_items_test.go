@@ -0,0 +1,387 @@
+package dynamodb_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccDynamoDBTableItems_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+	content := `[
+  {"pk": {"S": "a"}, "sk": {"N": "1"}, "value": {"S": "one"}},
+  {"pk": {"S": "a"}, "sk": {"N": "2"}, "value": {"S": "two"}},
+  {"pk": {"S": "b"}, "sk": {"N": "1"}, "value": {"SS": ["x", "y"]}}
+]`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTableItemsDestroy(testAccTableItemsConn, rName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig(rName, content),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsCount(testAccTableItemsConn, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "format", "DYNAMODB_JSON"),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", "pk"),
+					resource.TestCheckResourceAttr(resourceName, "item_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, `item_hashes.{"pk":{"S":"b"},"sk":{"N":"1"}}`),
+					resource.TestCheckResourceAttr(resourceName, "range_key", "sk"),
+					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "item_count", "item_hashes"},
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_multiple(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName1 := "aws_dynamodb_table_items.test1"
+	resourceName2 := "aws_dynamodb_table_items.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTableItemsDestroy(testAccTableItemsConn, rName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsMultipleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsCount(testAccTableItemsConn, rName, 3),
+					resource.TestCheckResourceAttr(resourceName1, "item_count", "2"),
+					resource.TestCheckResourceAttr(resourceName2, "item_count", "1"),
+					resource.TestMatchResourceAttr(resourceName1, "id", regexp.MustCompile(`^`+rName+`,.+`)),
+					resource.TestMatchResourceAttr(resourceName2, "id", regexp.MustCompile(`^`+rName+`,.+`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+	contentBefore := `{"pk": {"S": "a"}, "sk": {"N": "1"}, "value": {"S": "one"}}
+{"pk": {"S": "a"}, "sk": {"N": "2"}, "value": {"S": "two"}}
+{"pk": {"S": "b"}, "sk": {"N": "1"}, "value": {"S": "three"}}
+`
+	contentAfter := `{"pk": {"S": "a"}, "sk": {"N": "1"}, "value": {"S": "one"}}
+{"pk": {"S": "a"}, "sk": {"N": "2"}, "value": {"S": "changed"}}
+{"pk": {"S": "c"}, "sk": {"N": "1"}, "value": {"S": "added"}}
+{"pk": {"S": "c"}, "sk": {"N": "2"}, "value": {"S": "added"}}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTableItemsDestroy(testAccTableItemsConn, rName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig(rName, contentBefore),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsCount(testAccTableItemsConn, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "item_count", "3"),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig(rName, contentAfter),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsCount(testAccTableItemsConn, rName, 4),
+					resource.TestCheckResourceAttr(resourceName, "item_count", "4"),
+					resource.TestCheckNoResourceAttr(resourceName, `item_hashes.{"pk":{"S":"b"},"sk":{"N":"1"}}`),
+					resource.TestCheckResourceAttrSet(resourceName, `item_hashes.{"pk":{"S":"c"},"sk":{"N":"2"}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_csv(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+	content := `pk,sk,value,enabled
+a,1,one,true
+a,2,two,
+b,1,,false
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTableItemsDestroy(testAccTableItemsConn, rName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsCSVConfig(rName, content),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsCount(testAccTableItemsConn, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "format", "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "item_count", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, `item_hashes.{"pk":{"S":"b"},"sk":{"N":"1"}}`),
+				),
+			},
+		},
+	})
+}
+
+// TestAccDynamoDBTableItems_local runs against DynamoDB Local, e.g.
+// docker run -p 8000:8000 amazon/dynamodb-local and DYNAMODB_LOCAL_ENDPOINT=http://localhost:8000.
+func TestAccDynamoDBTableItems_local(t *testing.T) {
+	key := "DYNAMODB_LOCAL_ENDPOINT"
+	endpoint := os.Getenv(key)
+	if endpoint == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+	conn := dynamodb.New(session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("test", "test", ""),
+		Endpoint:    aws.String(endpoint),
+		Region:      aws.String("us-east-1"),
+	})))
+	connFunc := func() *dynamodb.DynamoDB { return conn }
+
+	// More items than fit in a single batch.
+	var content string
+	for i := 0; i < 60; i++ {
+		content += fmt.Sprintf("{\"pk\": {\"S\": \"item-%[1]d\"}, \"sk\": {\"N\": \"%[1]d\"}}\n", i)
+	}
+	contentAfter := content + `{"pk": {"S": "item-60"}, "sk": {"N": "60"}}` + "\n"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckTableItemsLocal(t, conn, rName) },
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckTableItemsDestroy(connFunc, rName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsLocalConfig(endpoint, rName, content),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsCount(connFunc, rName, 60),
+					resource.TestCheckResourceAttr(resourceName, "item_count", "60"),
+				),
+			},
+			{
+				Config: testAccTableItemsLocalConfig(endpoint, rName, contentAfter),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsCount(connFunc, rName, 61),
+					resource.TestCheckResourceAttr(resourceName, "item_count", "61"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTableItemsConn() *dynamodb.DynamoDB {
+	return acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+}
+
+// testAccPreCheckTableItemsLocal creates the table in DynamoDB Local, as aws_dynamodb_table
+// uses APIs that DynamoDB Local does not support.
+func testAccPreCheckTableItemsLocal(t *testing.T, conn *dynamodb.DynamoDB, tableName string) {
+	_, err := conn.CreateTable(&dynamodb.CreateTableInput{
+		AttributeDefinitions: []*dynamodb.AttributeDefinition{
+			{AttributeName: aws.String("pk"), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+			{AttributeName: aws.String("sk"), AttributeType: aws.String(dynamodb.ScalarAttributeTypeN)},
+		},
+		BillingMode: aws.String(dynamodb.BillingModePayPerRequest),
+		KeySchema: []*dynamodb.KeySchemaElement{
+			{AttributeName: aws.String("pk"), KeyType: aws.String(dynamodb.KeyTypeHash)},
+			{AttributeName: aws.String("sk"), KeyType: aws.String(dynamodb.KeyTypeRange)},
+		},
+		TableName: aws.String(tableName),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating DynamoDB Local table: %s", err)
+	}
+
+	t.Cleanup(func() {
+		conn.DeleteTable(&dynamodb.DeleteTableInput{TableName: aws.String(tableName)}) //nolint:errcheck
+	})
+}
+
+func testAccCheckTableItemsCount(conn func() *dynamodb.DynamoDB, tableName string, count int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		output, err := conn().Scan(&dynamodb.ScanInput{
+			ConsistentRead: aws.Bool(true),
+			Select:         aws.String(dynamodb.SelectCount),
+			TableName:      aws.String(tableName),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.Int64Value(output.Count); got != count {
+			return fmt.Errorf("expected %d items, got %d", count, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableItemsDestroy(conn func() *dynamodb.DynamoDB, tableName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dynamodb_table_items" {
+				continue
+			}
+
+			output, err := conn().Scan(&dynamodb.ScanInput{
+				ConsistentRead: aws.Bool(true),
+				Select:         aws.String(dynamodb.SelectCount),
+				TableName:      aws.String(tableName),
+			})
+
+			if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if n := aws.Int64Value(output.Count); n != 0 {
+				return fmt.Errorf("DynamoDB Table (%s) still has %d items", tableName, n)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccTableItemsBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "pk"
+  range_key    = "sk"
+
+  attribute {
+    name = "pk"
+    type = "S"
+  }
+
+  attribute {
+    name = "sk"
+    type = "N"
+  }
+}
+`, rName)
+}
+
+func testAccTableItemsConfig(rName, content string) string {
+	return acctest.ConfigCompose(testAccTableItemsBaseConfig(rName), `
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  content = <<CONTENT
+`+content+`
+CONTENT
+}
+`)
+}
+
+func testAccTableItemsMultipleConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTableItemsBaseConfig(rName), `
+resource "aws_dynamodb_table_items" "test1" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  content = jsonencode([
+    { "pk" = { "S" = "a" }, "sk" = { "N" = "1" } },
+    { "pk" = { "S" = "a" }, "sk" = { "N" = "2" } },
+  ])
+}
+
+resource "aws_dynamodb_table_items" "test2" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  content = jsonencode([
+    { "pk" = { "S" = "b" }, "sk" = { "N" = "1" } },
+  ])
+}
+`)
+}
+
+func testAccTableItemsCSVConfig(rName, content string) string {
+	return acctest.ConfigCompose(testAccTableItemsBaseConfig(rName), `
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+  format     = "CSV"
+
+  csv_attribute_types = {
+    enabled = "BOOL"
+    sk      = "N"
+  }
+
+  content = <<CONTENT
+`+content+`
+CONTENT
+}
+`)
+}
+
+func testAccTableItemsLocalConfig(endpoint, rName, content string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  access_key = "test"
+  secret_key = "test"
+  region     = "us-east-1"
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+
+  endpoints {
+    dynamodb = %[1]q
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = %[2]q
+  hash_key   = "pk"
+  range_key  = "sk"
+
+  content = <<CONTENT
+%[3]s
+CONTENT
+}
+`, endpoint, rName, content)
+}
@@ -3,10 +3,12 @@ package dynamodb_test
 import (
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -318,6 +320,86 @@ func TestUpdateDynamoDbDiffGSI(t *testing.T) {
 	}
 }
 
+func TestTableCreate_replicaPropagateTags(t *testing.T) {
+	tableARN := "arn:aws:dynamodb:us-west-2:123456789012:table/test"   //lintignore:AWSAT003,AWSAT005
+	replicaARN := "arn:aws:dynamodb:us-east-1:123456789012:table/test" //lintignore:AWSAT003,AWSAT005
+	var taggedARNs []string
+
+	table := &dynamodb.TableDescription{
+		AttributeDefinitions: []*dynamodb.AttributeDefinition{
+			{AttributeName: aws.String("pk"), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+		},
+		BillingModeSummary: &dynamodb.BillingModeSummary{BillingMode: aws.String(dynamodb.BillingModePayPerRequest)},
+		KeySchema: []*dynamodb.KeySchemaElement{
+			{AttributeName: aws.String("pk"), KeyType: aws.String(dynamodb.KeyTypeHash)},
+		},
+		Replicas: []*dynamodb.ReplicaDescription{
+			{RegionName: aws.String("us-east-1"), ReplicaStatus: aws.String(dynamodb.ReplicaStatusActive)}, //lintignore:AWSAT003
+		},
+		TableArn:    aws.String(tableARN),
+		TableName:   aws.String("test"),
+		TableStatus: aws.String(dynamodb.TableStatusActive),
+	}
+
+	r := tfdynamodb.ResourceTable()
+	meta := acctest.UnitTestClient(t, acctest.UnitTestFakes{
+		"CreateTable": func(input interface{}) (interface{}, error) {
+			return &dynamodb.CreateTableOutput{TableDescription: table}, nil
+		},
+		"DescribeTable": func(input interface{}) (interface{}, error) {
+			return &dynamodb.DescribeTableOutput{Table: table}, nil
+		},
+		"UpdateTable": func(input interface{}) (interface{}, error) {
+			return &dynamodb.UpdateTableOutput{TableDescription: table}, nil
+		},
+		"DescribeContinuousBackups": func(input interface{}) (interface{}, error) {
+			return &dynamodb.DescribeContinuousBackupsOutput{
+				ContinuousBackupsDescription: &dynamodb.ContinuousBackupsDescription{
+					ContinuousBackupsStatus: aws.String(dynamodb.ContinuousBackupsStatusEnabled),
+					PointInTimeRecoveryDescription: &dynamodb.PointInTimeRecoveryDescription{
+						PointInTimeRecoveryStatus: aws.String(dynamodb.PointInTimeRecoveryStatusDisabled),
+					},
+				},
+			}, nil
+		},
+		"DescribeTimeToLive": func(input interface{}) (interface{}, error) {
+			return &dynamodb.DescribeTimeToLiveOutput{
+				TimeToLiveDescription: &dynamodb.TimeToLiveDescription{TimeToLiveStatus: aws.String(dynamodb.TimeToLiveStatusDisabled)},
+			}, nil
+		},
+		"ListTagsOfResource": func(input interface{}) (interface{}, error) {
+			return &dynamodb.ListTagsOfResourceOutput{}, nil
+		},
+		"TagResource": func(input interface{}) (interface{}, error) {
+			taggedARNs = append(taggedARNs, aws.StringValue(input.(*dynamodb.TagResourceInput).ResourceArn))
+
+			return &dynamodb.TagResourceOutput{}, nil
+		},
+	})
+
+	_, err := acctest.UnitTestCreate(t, r, map[string]interface{}{
+		"name":         "test",
+		"hash_key":     "pk",
+		"billing_mode": dynamodb.BillingModePayPerRequest,
+		"attribute": []interface{}{
+			map[string]interface{}{"name": "pk", "type": dynamodb.ScalarAttributeTypeS},
+		},
+		"replica": []interface{}{
+			map[string]interface{}{"region_name": "us-east-1", "propagate_tags": true}, //lintignore:AWSAT003
+		},
+		"tags":     map[string]interface{}{"Name": "test"},
+		"tags_all": map[string]interface{}{"Name": "test"},
+	}, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := []string{replicaARN}; !reflect.DeepEqual(taggedARNs, expected) {
+		t.Errorf("expected tagged ARNs %v, got %v", expected, taggedARNs)
+	}
+}
+
 func TestAccDynamoDBTable_basic(t *testing.T) {
 	var conf dynamodb.DescribeTableOutput
 	resourceName := "aws_dynamodb_table.test"
@@ -1544,6 +1626,131 @@ func TestAccDynamoDBTable_Replica_singleWithCMK(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTable_Replica_pitr(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf dynamodb.DescribeTableOutput
+	var providers []*schema.Provider
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.FactoriesMultipleRegion(&providers, 3), // 3 due to shared test configuration
+		CheckDestroy:      testAccCheckTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableReplicaPITRConfig(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						"point_in_time_recovery": "true",
+					}),
+				),
+			},
+			{
+				Config: testAccTableReplicaPITRConfig(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						"point_in_time_recovery": "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTable_Replica_tagsPropagate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf dynamodb.DescribeTableOutput
+	var providers []*schema.Provider
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.FactoriesMultipleRegion(&providers, 3), // 3 due to shared test configuration
+		CheckDestroy:      testAccCheckTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableReplicaTagsPropagateConfig(rName, "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &conf),
+					testAccCheckTableReplicaTag(resourceName, acctest.AlternateRegion(), "key1", "value1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						"propagate_tags": "true",
+					}),
+				),
+			},
+			{
+				Config: testAccTableReplicaTagsPropagateConfig(rName, "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &conf),
+					testAccCheckTableReplicaTag(resourceName, acctest.AlternateRegion(), "key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTable_Replica_tableClassOverride(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf dynamodb.DescribeTableOutput
+	var providers []*schema.Provider
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.FactoriesMultipleRegion(&providers, 3), // 3 due to shared test configuration
+		CheckDestroy:      testAccCheckTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableReplicaTableClassOverrideConfig(rName, "STANDARD_INFREQUENT_ACCESS"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "table_class", "STANDARD"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						"table_class_override": "STANDARD_INFREQUENT_ACCESS",
+					}),
+				),
+			},
+			{
+				Config: testAccTableReplicaTableClassOverrideConfig(rName, "STANDARD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &conf),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						"table_class_override": "STANDARD",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDynamoDBTable_tableClassInfrequentAccess(t *testing.T) {
 	var table dynamodb.DescribeTableOutput
 	resourceName := "aws_dynamodb_table.test"
@@ -1721,6 +1928,42 @@ func testAccCheckInitialTableExists(n string, table *dynamodb.DescribeTableOutpu
 	}
 }
 
+func testAccCheckTableReplicaTag(n, region, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		replicaARN, err := arn.Parse(rs.Primary.Attributes["arn"])
+
+		if err != nil {
+			return err
+		}
+
+		replicaARN.Region = region
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+		session, err := conns.NewSessionForRegion(&conn.Config, region, acctest.Provider.Meta().(*conns.AWSClient).TerraformVersion)
+
+		if err != nil {
+			return err
+		}
+
+		tags, err := tfdynamodb.ListTags(dynamodb.New(session), replicaARN.String())
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.StringValue(tags.KeyValue(key)); got != value {
+			return fmt.Errorf("DynamoDB Table (%s) replica (%s) tag %s: expected %q, got %q", rs.Primary.ID, region, key, value, got)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckInitialTableConf(resourceName string) resource.TestCheckFunc {
 	return resource.ComposeAggregateTestCheckFunc(
 		resource.TestCheckResourceAttr(resourceName, "hash_key", "TestTableHashKey"),
@@ -2630,6 +2873,96 @@ resource "aws_dynamodb_table" "test" {
 `, rName))
 }
 
+func testAccTableReplicaPITRConfig(rName string, pitr bool) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(3), // Prevent "Provider configuration not present" errors
+		fmt.Sprintf(`
+data "aws_region" "alternate" {
+  provider = "awsalternate"
+}
+
+resource "aws_dynamodb_table" "test" {
+  name             = %[1]q
+  hash_key         = "TestTableHashKey"
+  billing_mode     = "PAY_PER_REQUEST"
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  replica {
+    region_name            = data.aws_region.alternate.name
+    point_in_time_recovery = %[2]t
+  }
+}
+`, rName, pitr))
+}
+
+func testAccTableReplicaTagsPropagateConfig(rName, value string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(3), // Prevent "Provider configuration not present" errors
+		fmt.Sprintf(`
+data "aws_region" "alternate" {
+  provider = "awsalternate"
+}
+
+resource "aws_dynamodb_table" "test" {
+  name             = %[1]q
+  hash_key         = "TestTableHashKey"
+  billing_mode     = "PAY_PER_REQUEST"
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  replica {
+    region_name    = data.aws_region.alternate.name
+    propagate_tags = true
+  }
+
+  tags = {
+    Name = %[1]q
+    key1 = %[2]q
+  }
+}
+`, rName, value))
+}
+
+func testAccTableReplicaTableClassOverrideConfig(rName, tableClass string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(3), // Prevent "Provider configuration not present" errors
+		fmt.Sprintf(`
+data "aws_region" "alternate" {
+  provider = "awsalternate"
+}
+
+resource "aws_dynamodb_table" "test" {
+  name             = %[1]q
+  hash_key         = "TestTableHashKey"
+  billing_mode     = "PAY_PER_REQUEST"
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+  table_class      = "STANDARD"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  replica {
+    region_name          = data.aws_region.alternate.name
+    table_class_override = %[2]q
+  }
+}
+`, rName, tableClass))
+}
+
 func testAccTableReplicaWithCMKConfig(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigMultipleRegionProvider(3), // Prevent "Provider configuration not present" errors
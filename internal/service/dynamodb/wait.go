@@ -2,10 +2,13 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -19,6 +22,8 @@ const (
 	deleteTableTimeout                         = 10 * time.Minute
 	pitrUpdateTimeout                          = 30 * time.Second
 	ttlUpdateTimeout                           = 30 * time.Second
+	tableItemsTimeout                          = 10 * time.Minute
+	tableExportCompletedTimeout                = 60 * time.Minute
 )
 
 func waitDynamoDBKinesisStreamingDestinationActive(ctx context.Context, conn *dynamodb.DynamoDB, streamArn, tableName string) error {
@@ -29,7 +34,7 @@ func waitDynamoDBKinesisStreamingDestinationActive(ctx context.Context, conn *dy
 		Refresh: statusDynamoDBKinesisStreamingDestination(ctx, conn, streamArn, tableName),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -42,7 +47,7 @@ func waitDynamoDBKinesisStreamingDestinationDisabled(ctx context.Context, conn *
 		Refresh: statusDynamoDBKinesisStreamingDestination(ctx, conn, streamArn, tableName),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -60,7 +65,7 @@ func waitDynamoDBTableActive(conn *dynamodb.DynamoDB, tableName string) (*dynamo
 		Refresh: statusDynamoDBTable(conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TableDescription); ok {
 		return output, err
@@ -80,7 +85,7 @@ func waitDynamoDBTableDeleted(conn *dynamodb.DynamoDB, tableName string) (*dynam
 		Refresh: statusDynamoDBTable(conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TableDescription); ok {
 		return output, err
@@ -103,7 +108,7 @@ func waitDynamoDBReplicaActive(conn *dynamodb.DynamoDB, tableName, region string
 		Refresh: statusDynamoDBReplicaUpdate(conn, tableName, region),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.DescribeTableOutput); ok {
 		return output, err
@@ -125,7 +130,7 @@ func waitDynamoDBReplicaDeleted(conn *dynamodb.DynamoDB, tableName, region strin
 		Refresh: statusDynamoDBReplicaDelete(conn, tableName, region),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.DescribeTableOutput); ok {
 		return output, err
@@ -147,7 +152,7 @@ func waitDynamoDBGSIActive(conn *dynamodb.DynamoDB, tableName, indexName string)
 		Refresh: statusDynamoDBGSI(conn, tableName, indexName),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.GlobalSecondaryIndexDescription); ok {
 		return output, err
@@ -168,7 +173,7 @@ func waitDynamoDBGSIDeleted(conn *dynamodb.DynamoDB, tableName, indexName string
 		Refresh: statusDynamoDBGSI(conn, tableName, indexName),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.GlobalSecondaryIndexDescription); ok {
 		return output, err
@@ -195,7 +200,7 @@ func waitDynamoDBPITRUpdated(conn *dynamodb.DynamoDB, tableName string, toEnable
 		Refresh: statusDynamoDBPITR(conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.PointInTimeRecoveryDescription); ok {
 		return output, err
@@ -226,7 +231,7 @@ func waitDynamoDBTTLUpdated(conn *dynamodb.DynamoDB, tableName string, toEnable
 		Refresh: statusDynamoDBTTL(conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TimeToLiveDescription); ok {
 		return output, err
@@ -250,7 +255,7 @@ func waitDynamoDBSSEUpdated(conn *dynamodb.DynamoDB, tableName string) (*dynamod
 		Refresh: statusDynamoDBTableSES(conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TableDescription); ok {
 		return output, err
@@ -267,7 +272,7 @@ func waitContributorInsightsCreated(ctx context.Context, conn *dynamodb.DynamoDB
 		Refresh: statusContributorInsights(ctx, conn, tableName, indexName),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -280,7 +285,29 @@ func waitContributorInsightsDeleted(ctx context.Context, conn *dynamodb.DynamoDB
 		Refresh: statusContributorInsights(ctx, conn, tableName, indexName),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
+
+func waitTableExportCompleted(conn *dynamodb.DynamoDB, arn string, timeout time.Duration) (*dynamodb.ExportDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.ExportStatusInProgress},
+		Target:  []string{dynamodb.ExportStatusCompleted},
+		Timeout: timeout,
+		Refresh: statusTableExport(conn, arn),
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForState(stateConf)
+
+	if output, ok := outputRaw.(*dynamodb.ExportDescription); ok {
+		if status := aws.StringValue(output.ExportStatus); status == dynamodb.ExportStatusFailed {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.FailureCode), aws.StringValue(output.FailureMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
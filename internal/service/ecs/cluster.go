@@ -186,7 +186,7 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 
 	input := &ecs.CreateClusterInput{
 		ClusterName:                     aws.String(clusterName),
-		DefaultCapacityProviderStrategy: expandCapacityProviderStrategy(d.Get("default_capacity_provider_strategy").(*schema.Set)),
+		DefaultCapacityProviderStrategy: ExpandCapacityProviderStrategy(d.Get("default_capacity_provider_strategy").(*schema.Set)),
 	}
 
 	if v, ok := d.GetOk("capacity_providers"); ok {
@@ -295,7 +295,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("capacity_providers", aws.StringValueSlice(cluster.CapacityProviders)); err != nil {
 		return fmt.Errorf("error setting capacity_providers: %w", err)
 	}
-	if err := d.Set("default_capacity_provider_strategy", flattenCapacityProviderStrategy(cluster.DefaultCapacityProviderStrategy)); err != nil {
+	if err := d.Set("default_capacity_provider_strategy", FlattenCapacityProviderStrategy(cluster.DefaultCapacityProviderStrategy)); err != nil {
 		return fmt.Errorf("error setting default_capacity_provider_strategy: %w", err)
 	}
 
@@ -353,7 +353,7 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		input := ecs.PutClusterCapacityProvidersInput{
 			Cluster:                         aws.String(d.Id()),
 			CapacityProviders:               flex.ExpandStringSet(d.Get("capacity_providers").(*schema.Set)),
-			DefaultCapacityProviderStrategy: expandCapacityProviderStrategy(d.Get("default_capacity_provider_strategy").(*schema.Set)),
+			DefaultCapacityProviderStrategy: ExpandCapacityProviderStrategy(d.Get("default_capacity_provider_strategy").(*schema.Set)),
 		}
 
 		err := retryClusterCapacityProvidersPut(context.Background(), conn, &input)
@@ -80,7 +80,7 @@ func resourceClusterCapacityProvidersPut(ctx context.Context, d *schema.Resource
 	input := &ecs.PutClusterCapacityProvidersInput{
 		Cluster:                         aws.String(clusterName),
 		CapacityProviders:               flex.ExpandStringSet(d.Get("capacity_providers").(*schema.Set)),
-		DefaultCapacityProviderStrategy: expandCapacityProviderStrategy(d.Get("default_capacity_provider_strategy").(*schema.Set)),
+		DefaultCapacityProviderStrategy: ExpandCapacityProviderStrategy(d.Get("default_capacity_provider_strategy").(*schema.Set)),
 	}
 
 	log.Printf("[DEBUG] Updating ECS cluster capacity providers: %s", input)
@@ -128,7 +128,7 @@ func resourceClusterCapacityProvidersRead(ctx context.Context, d *schema.Resourc
 
 	d.Set("cluster_name", cluster.ClusterName)
 
-	if err := d.Set("default_capacity_provider_strategy", flattenCapacityProviderStrategy(cluster.DefaultCapacityProviderStrategy)); err != nil {
+	if err := d.Set("default_capacity_provider_strategy", FlattenCapacityProviderStrategy(cluster.DefaultCapacityProviderStrategy)); err != nil {
 		return diag.Errorf("error setting default_capacity_provider_strategy: %s", err)
 	}
 
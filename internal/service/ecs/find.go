@@ -98,3 +98,81 @@ func FindClusterByNameOrARN(ctx context.Context, conn *ecs.ECS, nameOrARN string
 
 	return output.Clusters[0], nil
 }
+
+func FindServiceByIDAndCluster(conn *ecs.ECS, id, cluster string) (*ecs.Service, error) {
+	input := &ecs.DescribeServicesInput{
+		Services: aws.StringSlice([]string{id}),
+	}
+
+	if cluster != "" {
+		input.Cluster = aws.String(cluster)
+	}
+
+	output, err := conn.DescribeServices(input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException, ecs.ErrCodeServiceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Services) == 0 || output.Services[0] == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	if count := len(output.Services); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Services[0], nil
+}
+
+// FindServiceDeploymentStoppedTasks returns the stopped tasks that were started by the specified service deployment.
+func FindServiceDeploymentStoppedTasks(conn *ecs.ECS, cluster, deploymentID string, maxResults int) ([]*ecs.Task, error) {
+	input := &ecs.ListTasksInput{
+		DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+		MaxResults:    aws.Int64(int64(maxResults)),
+		StartedBy:     aws.String(deploymentID),
+	}
+
+	if cluster != "" {
+		input.Cluster = aws.String(cluster)
+	}
+
+	output, err := conn.ListTasks(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TaskArns) == 0 {
+		return nil, nil
+	}
+
+	describeInput := &ecs.DescribeTasksInput{
+		Tasks: output.TaskArns,
+	}
+
+	if cluster != "" {
+		describeInput.Cluster = aws.String(cluster)
+	}
+
+	describeOutput, err := conn.DescribeTasks(describeInput)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if describeOutput == nil {
+		return nil, nil
+	}
+
+	return describeOutput.Tasks, nil
+}
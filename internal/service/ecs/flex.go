@@ -1,12 +1,156 @@
 package ecs
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
-func expandCapacityProviderStrategy(cps *schema.Set) []*ecs.CapacityProviderStrategyItem {
+// CapacityProviderStrategySchema returns the schema of a capacity provider strategy.
+// It is shared by resources that run ECS tasks, such as aws_ecs_service and aws_cloudwatch_event_target.
+func CapacityProviderStrategySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"base": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 100000),
+				},
+				"capacity_provider": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"weight": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 1000),
+				},
+			},
+		},
+	}
+}
+
+// PlacementStrategySchema returns the schema of an ordered list of task placement strategies.
+func PlacementStrategySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 5,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"field": {
+					Type:     schema.TypeString,
+					Optional: true,
+					StateFunc: func(v interface{}) string {
+						value := v.(string)
+						if value == "host" {
+							return "instanceId"
+						}
+						return value
+					},
+					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+						return strings.EqualFold(old, new)
+					},
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(ecs.PlacementStrategyType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+// TaskOverrideSchema returns the schema of the overrides applied to a task when it is run.
+func TaskOverrideSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"container_override": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"command": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"cpu": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(0),
+							},
+							"environment": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"name": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"value": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+							"memory": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(0),
+							},
+							"memory_reservation": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(0),
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+				"cpu": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"execution_role_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"memory": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"task_role_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func ExpandCapacityProviderStrategy(cps *schema.Set) []*ecs.CapacityProviderStrategyItem {
 	list := cps.List()
 	results := make([]*ecs.CapacityProviderStrategyItem, 0)
 	for _, raw := range list {
@@ -27,7 +171,7 @@ func expandCapacityProviderStrategy(cps *schema.Set) []*ecs.CapacityProviderStra
 	return results
 }
 
-func flattenCapacityProviderStrategy(cps []*ecs.CapacityProviderStrategyItem) []map[string]interface{} {
+func FlattenCapacityProviderStrategy(cps []*ecs.CapacityProviderStrategyItem) []map[string]interface{} {
 	if cps == nil {
 		return nil
 	}
@@ -46,6 +190,68 @@ func flattenCapacityProviderStrategy(cps []*ecs.CapacityProviderStrategyItem) []
 	return results
 }
 
+func ExpandPlacementStrategy(s []interface{}) ([]*ecs.PlacementStrategy, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	pss := make([]*ecs.PlacementStrategy, 0)
+	for _, raw := range s {
+		p, ok := raw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		t, ok := p["type"].(string)
+
+		if !ok {
+			return nil, fmt.Errorf("missing type attribute in placement strategy configuration block")
+		}
+
+		f, ok := p["field"].(string)
+
+		if !ok {
+			return nil, fmt.Errorf("missing field attribute in placement strategy configuration block")
+		}
+
+		if err := validPlacementStrategy(t, f); err != nil {
+			return nil, err
+		}
+		ps := &ecs.PlacementStrategy{
+			Type: aws.String(t),
+		}
+		if f != "" {
+			// Field must be omitted (i.e. not empty string) for random strategy
+			ps.Field = aws.String(f)
+		}
+		pss = append(pss, ps)
+	}
+	return pss, nil
+}
+
+func FlattenPlacementStrategy(pss []*ecs.PlacementStrategy) []interface{} {
+	if len(pss) == 0 {
+		return nil
+	}
+	results := make([]interface{}, 0, len(pss))
+	for _, ps := range pss {
+		c := make(map[string]interface{})
+		c["type"] = aws.StringValue(ps.Type)
+
+		if ps.Field != nil {
+			c["field"] = aws.StringValue(ps.Field)
+
+			// for some fields the API requires lowercase for creation but will return uppercase on query
+			if aws.StringValue(ps.Field) == "MEMORY" || aws.StringValue(ps.Field) == "CPU" {
+				c["field"] = strings.ToLower(aws.StringValue(ps.Field))
+			}
+		}
+
+		results = append(results, c)
+	}
+	return results
+}
+
 // Takes the result of flatmap. Expand for an array of load balancers and
 // returns ecs.LoadBalancer compatible objects
 func expandLoadBalancers(configured []interface{}) []*ecs.LoadBalancer {
@@ -221,3 +427,127 @@ func flattenScale(scale *ecs.Scale) []map[string]interface{} {
 
 	return []map[string]interface{}{m}
 }
+
+func ExpandTaskOverride(tfList []interface{}) *ecs.TaskOverride {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &ecs.TaskOverride{}
+
+	if v, ok := tfMap["container_override"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ContainerOverrides = expandContainerOverrides(v)
+	}
+
+	if v, ok := tfMap["cpu"].(string); ok && v != "" {
+		apiObject.Cpu = aws.String(v)
+	}
+
+	if v, ok := tfMap["execution_role_arn"].(string); ok && v != "" {
+		apiObject.ExecutionRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["memory"].(string); ok && v != "" {
+		apiObject.Memory = aws.String(v)
+	}
+
+	if v, ok := tfMap["task_role_arn"].(string); ok && v != "" {
+		apiObject.TaskRoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandContainerOverrides(tfList []interface{}) []*ecs.ContainerOverride {
+	var apiObjects []*ecs.ContainerOverride
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.ContainerOverride{
+			Name: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Command = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["cpu"].(int); ok && v > 0 {
+			apiObject.Cpu = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["environment"].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfMapRaw := range v.List() {
+				tfMap := tfMapRaw.(map[string]interface{})
+
+				apiObject.Environment = append(apiObject.Environment, &ecs.KeyValuePair{
+					Name:  aws.String(tfMap["name"].(string)),
+					Value: aws.String(tfMap["value"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["memory"].(int); ok && v > 0 {
+			apiObject.Memory = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["memory_reservation"].(int); ok && v > 0 {
+			apiObject.MemoryReservation = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func FlattenTaskOverride(apiObject *ecs.TaskOverride) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"container_override": flattenContainerOverrides(apiObject.ContainerOverrides),
+		"cpu":                aws.StringValue(apiObject.Cpu),
+		"execution_role_arn": aws.StringValue(apiObject.ExecutionRoleArn),
+		"memory":             aws.StringValue(apiObject.Memory),
+		"task_role_arn":      aws.StringValue(apiObject.TaskRoleArn),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenContainerOverrides(apiObjects []*ecs.ContainerOverride) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		var environment []interface{}
+
+		for _, v := range apiObject.Environment {
+			environment = append(environment, map[string]interface{}{
+				"name":  aws.StringValue(v.Name),
+				"value": aws.StringValue(v.Value),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"command":            aws.StringValueSlice(apiObject.Command),
+			"cpu":                int(aws.Int64Value(apiObject.Cpu)),
+			"environment":        environment,
+			"memory":             int(aws.Int64Value(apiObject.Memory)),
+			"memory_reservation": int(aws.Int64Value(apiObject.MemoryReservation)),
+			"name":               aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,185 @@
+package ecs
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandFlattenCapacityProviderStrategy(t *testing.T) {
+	tfSet := schema.NewSet(schema.HashResource(CapacityProviderStrategySchema().Elem.(*schema.Resource)), []interface{}{
+		map[string]interface{}{
+			"base":              1,
+			"capacity_provider": "FARGATE",
+			"weight":            1,
+		},
+	})
+
+	expected := []*ecs.CapacityProviderStrategyItem{
+		{
+			Base:             aws.Int64(1),
+			CapacityProvider: aws.String("FARGATE"),
+			Weight:           aws.Int64(1),
+		},
+	}
+
+	apiObjects := ExpandCapacityProviderStrategy(tfSet)
+
+	if !reflect.DeepEqual(apiObjects, expected) {
+		t.Errorf("expected %v, got %v", expected, apiObjects)
+	}
+
+	tfList := FlattenCapacityProviderStrategy(apiObjects)
+	expectedTfList := []map[string]interface{}{
+		{
+			"base":              int64(1),
+			"capacity_provider": "FARGATE",
+			"weight":            int64(1),
+		},
+	}
+
+	if !reflect.DeepEqual(tfList, expectedTfList) {
+		t.Errorf("expected %v, got %v", expectedTfList, tfList)
+	}
+}
+
+func TestExpandFlattenPlacementStrategy(t *testing.T) {
+	tfList := []interface{}{
+		map[string]interface{}{
+			"field": "memory",
+			"type":  ecs.PlacementStrategyTypeBinpack,
+		},
+		map[string]interface{}{
+			"field": "",
+			"type":  ecs.PlacementStrategyTypeRandom,
+		},
+	}
+
+	expected := []*ecs.PlacementStrategy{
+		{
+			Field: aws.String("memory"),
+			Type:  aws.String(ecs.PlacementStrategyTypeBinpack),
+		},
+		{
+			Type: aws.String(ecs.PlacementStrategyTypeRandom),
+		},
+	}
+
+	apiObjects, err := ExpandPlacementStrategy(tfList)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(apiObjects, expected) {
+		t.Errorf("expected %v, got %v", expected, apiObjects)
+	}
+
+	// The API returns some fields in upper case.
+	apiObjects[0].Field = aws.String("MEMORY")
+
+	expectedTfList := []interface{}{
+		map[string]interface{}{
+			"field": "memory",
+			"type":  ecs.PlacementStrategyTypeBinpack,
+		},
+		map[string]interface{}{
+			"type": ecs.PlacementStrategyTypeRandom,
+		},
+	}
+
+	if got := FlattenPlacementStrategy(apiObjects); !reflect.DeepEqual(got, expectedTfList) {
+		t.Errorf("expected %v, got %v", expectedTfList, got)
+	}
+
+	if _, err := ExpandPlacementStrategy([]interface{}{map[string]interface{}{"field": "memory", "type": ecs.PlacementStrategyTypeRandom}}); err == nil {
+		t.Error("expected an error for a random strategy with a field")
+	}
+}
+
+func TestExpandFlattenTaskOverride(t *testing.T) {
+	environmentSchema := TaskOverrideSchema().Elem.(*schema.Resource).Schema["container_override"].Elem.(*schema.Resource).Schema["environment"]
+
+	tfList := []interface{}{
+		map[string]interface{}{
+			"container_override": []interface{}{
+				map[string]interface{}{
+					"command": []interface{}{"echo", "hello"},
+					"cpu":     256,
+					"environment": schema.NewSet(schema.HashResource(environmentSchema.Elem.(*schema.Resource)), []interface{}{
+						map[string]interface{}{
+							"name":  "STAGE",
+							"value": "test",
+						},
+					}),
+					"memory":             0,
+					"memory_reservation": 0,
+					"name":               "app",
+				},
+			},
+			"cpu":                "",
+			"execution_role_arn": "",
+			"memory":             "1024",
+			"task_role_arn":      "arn:aws:iam::123456789012:role/task",
+		},
+	}
+
+	expected := &ecs.TaskOverride{
+		ContainerOverrides: []*ecs.ContainerOverride{
+			{
+				Command: aws.StringSlice([]string{"echo", "hello"}),
+				Cpu:     aws.Int64(256),
+				Environment: []*ecs.KeyValuePair{
+					{
+						Name:  aws.String("STAGE"),
+						Value: aws.String("test"),
+					},
+				},
+				Name: aws.String("app"),
+			},
+		},
+		Memory:      aws.String("1024"),
+		TaskRoleArn: aws.String("arn:aws:iam::123456789012:role/task"),
+	}
+
+	apiObject := ExpandTaskOverride(tfList)
+
+	if !reflect.DeepEqual(apiObject, expected) {
+		t.Errorf("expected %v, got %v", expected, apiObject)
+	}
+
+	expectedTfList := []interface{}{
+		map[string]interface{}{
+			"container_override": []interface{}{
+				map[string]interface{}{
+					"command": []string{"echo", "hello"},
+					"cpu":     256,
+					"environment": []interface{}{
+						map[string]interface{}{
+							"name":  "STAGE",
+							"value": "test",
+						},
+					},
+					"memory":             0,
+					"memory_reservation": 0,
+					"name":               "app",
+				},
+			},
+			"cpu":                "",
+			"execution_role_arn": "",
+			"memory":             "1024",
+			"task_role_arn":      "arn:aws:iam::123456789012:role/task",
+		},
+	}
+
+	if got := FlattenTaskOverride(apiObject); !reflect.DeepEqual(got, expectedTfList) {
+		t.Errorf("expected %v, got %v", expectedTfList, got)
+	}
+
+	if got := ExpandTaskOverride(nil); got != nil {
+		t.Errorf("expected nil, got %v", got)
+	}
+}
@@ -41,28 +41,7 @@ func ResourceService() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"capacity_provider_strategy": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"base": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntBetween(0, 100000),
-						},
-						"capacity_provider": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"weight": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntBetween(0, 1000),
-						},
-					},
-				},
-			},
+			"capacity_provider_strategy": CapacityProviderStrategySchema(),
 			"cluster": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -138,6 +117,11 @@ func ResourceService() *schema.Resource {
 					return false
 				},
 			},
+			"deployment_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validDeploymentTimeout,
+			},
 			"desired_count": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -235,34 +219,7 @@ func ResourceService() *schema.Resource {
 					},
 				},
 			},
-			"ordered_placement_strategy": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 5,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"field": {
-							Type:     schema.TypeString,
-							Optional: true,
-							StateFunc: func(v interface{}) string {
-								value := v.(string)
-								if value == "host" {
-									return "instanceId"
-								}
-								return value
-							},
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								return strings.EqualFold(old, new)
-							},
-						},
-						"type": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice(ecs.PlacementStrategyType_Values(), false),
-						},
-					},
-				},
-			},
+			"ordered_placement_strategy": PlacementStrategySchema(),
 			"placement_constraints": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -467,7 +424,7 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 		input.PlatformVersion = aws.String(v.(string))
 	}
 
-	input.CapacityProviderStrategy = expandCapacityProviderStrategy(d.Get("capacity_provider_strategy").(*schema.Set))
+	input.CapacityProviderStrategy = ExpandCapacityProviderStrategy(d.Get("capacity_provider_strategy").(*schema.Set))
 
 	loadBalancers := expandLoadBalancers(d.Get("load_balancer").(*schema.Set).List())
 	if len(loadBalancers) > 0 {
@@ -481,7 +438,7 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 	input.NetworkConfiguration = expandNetworkConfiguration(d.Get("network_configuration").([]interface{}))
 
 	if v, ok := d.GetOk("ordered_placement_strategy"); ok {
-		ps, err := expandPlacementStrategy(v.([]interface{}))
+		ps, err := ExpandPlacementStrategy(v.([]interface{}))
 
 		if err != nil {
 			return err
@@ -553,7 +510,7 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 	cluster := d.Get("cluster").(string)
 
 	if d.Get("wait_for_steady_state").(bool) {
-		if err := waitServiceStable(conn, d.Id(), cluster); err != nil {
+		if err := waitServiceSteadyState(d, conn, output.Service); err != nil {
 			return fmt.Errorf("error waiting for ECS service (%s) to reach steady state after creation: %w", d.Id(), err)
 		}
 	} else {
@@ -707,11 +664,11 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("load_balancer", flattenLoadBalancers(service.LoadBalancers))
 	}
 
-	if err := d.Set("capacity_provider_strategy", flattenCapacityProviderStrategy(service.CapacityProviderStrategy)); err != nil {
+	if err := d.Set("capacity_provider_strategy", FlattenCapacityProviderStrategy(service.CapacityProviderStrategy)); err != nil {
 		return fmt.Errorf("error setting capacity_provider_strategy: %w", err)
 	}
 
-	if err := d.Set("ordered_placement_strategy", flattenPlacementStrategy(service.PlacementStrategy)); err != nil {
+	if err := d.Set("ordered_placement_strategy", FlattenPlacementStrategy(service.PlacementStrategy)); err != nil {
 		return fmt.Errorf("error setting ordered_placement_strategy: %w", err)
 	}
 
@@ -882,68 +839,6 @@ func flattenServicePlacementConstraints(pcs []*ecs.PlacementConstraint) []map[st
 	return results
 }
 
-func expandPlacementStrategy(s []interface{}) ([]*ecs.PlacementStrategy, error) {
-	if len(s) == 0 {
-		return nil, nil
-	}
-	pss := make([]*ecs.PlacementStrategy, 0)
-	for _, raw := range s {
-		p, ok := raw.(map[string]interface{})
-
-		if !ok {
-			continue
-		}
-
-		t, ok := p["type"].(string)
-
-		if !ok {
-			return nil, fmt.Errorf("missing type attribute in placement strategy configuration block")
-		}
-
-		f, ok := p["field"].(string)
-
-		if !ok {
-			return nil, fmt.Errorf("missing field attribute in placement strategy configuration block")
-		}
-
-		if err := validPlacementStrategy(t, f); err != nil {
-			return nil, err
-		}
-		ps := &ecs.PlacementStrategy{
-			Type: aws.String(t),
-		}
-		if f != "" {
-			// Field must be omitted (i.e. not empty string) for random strategy
-			ps.Field = aws.String(f)
-		}
-		pss = append(pss, ps)
-	}
-	return pss, nil
-}
-
-func flattenPlacementStrategy(pss []*ecs.PlacementStrategy) []interface{} {
-	if len(pss) == 0 {
-		return nil
-	}
-	results := make([]interface{}, 0, len(pss))
-	for _, ps := range pss {
-		c := make(map[string]interface{})
-		c["type"] = aws.StringValue(ps.Type)
-
-		if ps.Field != nil {
-			c["field"] = aws.StringValue(ps.Field)
-
-			// for some fields the API requires lowercase for creation but will return uppercase on query
-			if aws.StringValue(ps.Field) == "MEMORY" || aws.StringValue(ps.Field) == "CPU" {
-				c["field"] = strings.ToLower(aws.StringValue(ps.Field))
-			}
-		}
-
-		results = append(results, c)
-	}
-	return results
-}
-
 func flattenServiceRegistries(srs []*ecs.ServiceRegistry) []map[string]interface{} {
 	if len(srs) == 0 {
 		return nil
@@ -1017,7 +912,7 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.PlacementStrategy = []*ecs.PlacementStrategy{}
 
 			if v, ok := d.GetOk("ordered_placement_strategy"); ok && len(v.([]interface{})) > 0 {
-				ps, err := expandPlacementStrategy(v.([]interface{}))
+				ps, err := ExpandPlacementStrategy(v.([]interface{}))
 
 				if err != nil {
 					return err
@@ -1060,7 +955,7 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if d.HasChange("capacity_provider_strategy") {
-			input.CapacityProviderStrategy = expandCapacityProviderStrategy(d.Get("capacity_provider_strategy").(*schema.Set))
+			input.CapacityProviderStrategy = ExpandCapacityProviderStrategy(d.Get("capacity_provider_strategy").(*schema.Set))
 		}
 
 		if d.HasChange("enable_execute_command") {
@@ -1086,9 +981,11 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] Updating ECS Service (%s): %s", d.Id(), input)
+		var output *ecs.UpdateServiceOutput
 		// Retry due to IAM eventual consistency
 		err := resource.Retry(tfiam.PropagationTimeout+serviceUpdateTimeout, func() *resource.RetryError {
-			_, err := conn.UpdateService(input)
+			var err error
+			output, err = conn.UpdateService(input)
 
 			if err != nil {
 				if tfawserr.ErrMessageContains(err, ecs.ErrCodeInvalidParameterException, "verify that the ECS service role being passed has the proper permissions") {
@@ -1105,7 +1002,7 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 		})
 
 		if tfresource.TimedOut(err) {
-			_, err = conn.UpdateService(input)
+			output, err = conn.UpdateService(input)
 		}
 
 		if err != nil {
@@ -1114,7 +1011,7 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		cluster := d.Get("cluster").(string)
 		if d.Get("wait_for_steady_state").(bool) {
-			if err := waitServiceStable(conn, d.Id(), cluster); err != nil {
+			if err := waitServiceSteadyState(d, conn, output.Service); err != nil {
 				return fmt.Errorf("error waiting for ECS service (%s) to reach steady state after update: %w", d.Id(), err)
 			}
 		} else {
@@ -1238,6 +1135,57 @@ func resourceLoadBalancerHash(v interface{}) int {
 	return create.StringHashcode(buf.String())
 }
 
+// waitServiceSteadyState waits for the deployment created by a CreateService or UpdateService call to complete.
+// Services without a PRIMARY deployment, e.g. those using an external deployment controller,
+// fall back to waiting for the service to become stable.
+func waitServiceSteadyState(d *schema.ResourceData, conn *ecs.ECS, service *ecs.Service) error {
+	cluster := d.Get("cluster").(string)
+	deployment := findServicePrimaryDeployment(service)
+
+	if deployment == nil {
+		return waitServiceStable(conn, d.Id(), cluster)
+	}
+
+	timeout := serviceDeploymentTimeout
+
+	if v, ok := d.GetOk("deployment_timeout"); ok {
+		// Validated by schema.
+		timeout, _ = time.ParseDuration(v.(string))
+	}
+
+	_, err := waitServiceDeploymentStable(conn, d.Id(), cluster, aws.StringValue(deployment.Id), timeout)
+
+	return err
+}
+
+func findServiceDeploymentByID(service *ecs.Service, id string) *ecs.Deployment {
+	if service == nil {
+		return nil
+	}
+
+	for _, deployment := range service.Deployments {
+		if aws.StringValue(deployment.Id) == id {
+			return deployment
+		}
+	}
+
+	return nil
+}
+
+func findServicePrimaryDeployment(service *ecs.Service) *ecs.Deployment {
+	if service == nil {
+		return nil
+	}
+
+	for _, deployment := range service.Deployments {
+		if aws.StringValue(deployment.Status) == serviceDeploymentStatusPrimary {
+			return deployment
+		}
+	}
+
+	return nil
+}
+
 func retryServiceCreate(conn *ecs.ECS, input ecs.CreateServiceInput) (*ecs.CreateServiceOutput, error) {
 	var output *ecs.CreateServiceOutput
 	err := resource.Retry(tfiam.PropagationTimeout+serviceCreateTimeout, func() *resource.RetryError {
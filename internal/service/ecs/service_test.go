@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"regexp"
+	"strconv"
 	"testing"
 	"time"
 
@@ -896,7 +897,7 @@ func TestAccECSService_LaunchTypeFargate_waitForSteadyState(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				// Wait for the ECS Cluster to reach a steady state w/specified count
-				Config: testAccServiceLaunchTypeFargateAndWaitConfig(rName, 1, true),
+				Config: testAccServiceLaunchTypeFargateAndWaitConfig(rName, 1, true, ""),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceExists(resourceName, &service),
 					resource.TestCheckResourceAttr(resourceName, "desired_count", "1"),
@@ -937,7 +938,7 @@ func TestAccECSService_LaunchTypeFargate_updateWaitForSteadyState(t *testing.T)
 			},
 			{
 				// Modify desired count and wait for the ECS Cluster to reach steady state
-				Config: testAccServiceLaunchTypeFargateAndWaitConfig(rName, 2, true),
+				Config: testAccServiceLaunchTypeFargateAndWaitConfig(rName, 2, true, ""),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceExists(resourceName, &service),
 					resource.TestCheckResourceAttr(resourceName, "desired_count", "2"),
@@ -946,7 +947,7 @@ func TestAccECSService_LaunchTypeFargate_updateWaitForSteadyState(t *testing.T)
 			},
 			{
 				// Modify desired count without wait
-				Config: testAccServiceLaunchTypeFargateAndWaitConfig(rName, 1, false),
+				Config: testAccServiceLaunchTypeFargateAndWaitConfig(rName, 1, false, ""),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceExists(resourceName, &service),
 					resource.TestCheckResourceAttr(resourceName, "desired_count", "1"),
@@ -957,6 +958,40 @@ func TestAccECSService_LaunchTypeFargate_updateWaitForSteadyState(t *testing.T)
 	})
 }
 
+func TestAccECSService_LaunchTypeFargate_deploymentTimeout(t *testing.T) {
+	var service ecs.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLaunchTypeFargateAndWaitConfig(rName, 1, true, "15m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "desired_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_timeout", "15m"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_steady_state", "true"),
+				),
+			},
+			{
+				// Modify desired count and wait for the new deployment to complete
+				Config: testAccServiceLaunchTypeFargateAndWaitConfig(rName, 2, true, "20m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "desired_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_timeout", "20m"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_steady_state", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccECSService_LaunchTypeEC2_network(t *testing.T) {
 	var service ecs.Service
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1539,7 +1574,12 @@ resource "aws_ecs_service" "test" {
 `, rName)
 }
 
-func testAccServiceLaunchTypeFargateAndWaitConfig(rName string, desiredCount int, waitForSteadyState bool) string {
+func testAccServiceLaunchTypeFargateAndWaitConfig(rName string, desiredCount int, waitForSteadyState bool, deploymentTimeout string) string {
+	deploymentTimeoutValue := "null"
+	if deploymentTimeout != "" {
+		deploymentTimeoutValue = strconv.Quote(deploymentTimeout)
+	}
+
 	return fmt.Sprintf(`
 data "aws_availability_zones" "available" {
   state = "available"
@@ -1640,7 +1680,7 @@ resource "aws_ecs_service" "test" {
   name            = %[1]q
   cluster         = aws_ecs_cluster.test.id
   task_definition = aws_ecs_task_definition.test.arn
-  desired_count   = %d
+  desired_count   = %[2]d
   launch_type     = "FARGATE"
 
   network_configuration {
@@ -1649,10 +1689,10 @@ resource "aws_ecs_service" "test" {
     assign_public_ip = true
   }
 
-  wait_for_steady_state = %t
+  wait_for_steady_state = %[3]t
+  deployment_timeout    = %[4]s
 }
-
-`, rName, desiredCount, waitForSteadyState)
+`, rName, desiredCount, waitForSteadyState, deploymentTimeoutValue)
 }
 
 func testAccServiceInterchangeablePlacementStrategyConfig(rName string) string {
@@ -26,6 +26,9 @@ const (
 	taskSetStatusActive   = "ACTIVE"
 	taskSetStatusDraining = "DRAINING"
 	taskSetStatusPrimary  = "PRIMARY"
+
+	serviceDeploymentStatusPrimary  = "PRIMARY"
+	serviceDeploymentStatusNotFound = "NOT_FOUND"
 )
 
 func statusCapacityProvider(conn *ecs.ECS, arn string) resource.StateRefreshFunc {
@@ -86,6 +89,44 @@ func statusService(conn *ecs.ECS, id, cluster string) resource.StateRefreshFunc
 	}
 }
 
+func statusServiceDeployment(conn *ecs.ECS, id, cluster, deploymentID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindServiceByIDAndCluster(conn, id, cluster)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, serviceDeploymentRolloutState(output, deploymentID), nil
+	}
+}
+
+// serviceDeploymentRolloutState returns the rollout state of the specified service deployment.
+// A deployment is only considered complete once it is the service's sole deployment and all of its
+// tasks are running, mirroring the ECS services-stable waiter. This also covers services that do not
+// report a rollout state, e.g. those behind a Classic Load Balancer.
+func serviceDeploymentRolloutState(service *ecs.Service, deploymentID string) string {
+	deployment := findServiceDeploymentByID(service, deploymentID)
+
+	if deployment == nil {
+		return serviceDeploymentStatusNotFound
+	}
+
+	if state := aws.StringValue(deployment.RolloutState); state == ecs.DeploymentRolloutStateFailed {
+		return state
+	}
+
+	if len(service.Deployments) == 1 && aws.Int64Value(deployment.RunningCount) == aws.Int64Value(deployment.DesiredCount) {
+		return ecs.DeploymentRolloutStateCompleted
+	}
+
+	return ecs.DeploymentRolloutStateInProgress
+}
+
 func statusCluster(ctx context.Context, conn *ecs.ECS, arn string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		cluster, err := FindClusterByNameOrARN(ctx, conn, arn)
@@ -0,0 +1,90 @@
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestServiceDeploymentRolloutState(t *testing.T) {
+	testCases := []struct {
+		TestName     string
+		Service      *ecs.Service
+		DeploymentID string
+		Expected     string
+	}{
+		{
+			TestName: "missing deployment",
+			Service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/2"), Status: aws.String("PRIMARY")},
+				},
+			},
+			DeploymentID: "ecs-svc/1",
+			Expected:     serviceDeploymentStatusNotFound,
+		},
+		{
+			TestName: "in progress with previous deployment draining",
+			Service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/2"), Status: aws.String("PRIMARY"), RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress), DesiredCount: aws.Int64(2), RunningCount: aws.Int64(2)},
+					{Id: aws.String("ecs-svc/1"), Status: aws.String("ACTIVE"), RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted), DesiredCount: aws.Int64(2), RunningCount: aws.Int64(1)},
+				},
+			},
+			DeploymentID: "ecs-svc/2",
+			Expected:     ecs.DeploymentRolloutStateInProgress,
+		},
+		{
+			TestName: "tasks still starting",
+			Service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/1"), Status: aws.String("PRIMARY"), DesiredCount: aws.Int64(2), RunningCount: aws.Int64(1)},
+				},
+			},
+			DeploymentID: "ecs-svc/1",
+			Expected:     ecs.DeploymentRolloutStateInProgress,
+		},
+		{
+			TestName: "completed",
+			Service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/1"), Status: aws.String("PRIMARY"), RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted), DesiredCount: aws.Int64(2), RunningCount: aws.Int64(2)},
+				},
+			},
+			DeploymentID: "ecs-svc/1",
+			Expected:     ecs.DeploymentRolloutStateCompleted,
+		},
+		{
+			TestName: "completed without rollout state",
+			Service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/1"), Status: aws.String("PRIMARY"), DesiredCount: aws.Int64(1), RunningCount: aws.Int64(1)},
+				},
+			},
+			DeploymentID: "ecs-svc/1",
+			Expected:     ecs.DeploymentRolloutStateCompleted,
+		},
+		{
+			TestName: "circuit breaker rollback",
+			Service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/3"), Status: aws.String("PRIMARY"), RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress)},
+					{Id: aws.String("ecs-svc/2"), Status: aws.String("ACTIVE"), RolloutState: aws.String(ecs.DeploymentRolloutStateFailed)},
+				},
+			},
+			DeploymentID: "ecs-svc/2",
+			Expected:     ecs.DeploymentRolloutStateFailed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := serviceDeploymentRolloutState(testCase.Service, testCase.DeploymentID)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
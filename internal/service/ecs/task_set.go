@@ -301,7 +301,7 @@ func resourceTaskSetCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if v, ok := d.GetOk("capacity_provider_strategy"); ok && v.(*schema.Set).Len() > 0 {
-		input.CapacityProviderStrategy = expandCapacityProviderStrategy(v.(*schema.Set))
+		input.CapacityProviderStrategy = ExpandCapacityProviderStrategy(v.(*schema.Set))
 	}
 
 	if v, ok := d.GetOk("external_id"); ok {
@@ -435,7 +435,7 @@ func resourceTaskSetRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("task_definition", taskSet.TaskDefinition)
 	d.Set("task_set_id", taskSet.Id)
 
-	if err := d.Set("capacity_provider_strategy", flattenCapacityProviderStrategy(taskSet.CapacityProviderStrategy)); err != nil {
+	if err := d.Set("capacity_provider_strategy", FlattenCapacityProviderStrategy(taskSet.CapacityProviderStrategy)); err != nil {
 		return fmt.Errorf("error setting capacity_provider_strategy: %w", err)
 	}
 
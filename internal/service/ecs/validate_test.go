@@ -86,3 +86,43 @@ func TestValidPlacementStrategy(t *testing.T) {
 		}
 	}
 }
+
+func TestValidDeploymentTimeout(t *testing.T) {
+	cases := []struct {
+		Value string
+		Err   bool
+	}{
+		{
+			Value: "10m",
+			Err:   false,
+		},
+		{
+			Value: "1h30m",
+			Err:   false,
+		},
+		{
+			Value: "0s",
+			Err:   true,
+		},
+		{
+			Value: "-5m",
+			Err:   true,
+		},
+		{
+			Value: "ten minutes",
+			Err:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validDeploymentTimeout(tc.Value, "deployment_timeout")
+
+		if len(errors) > 0 && !tc.Err {
+			t.Fatalf("Unexpected validation error for %q: %s", tc.Value, errors)
+		}
+
+		if len(errors) == 0 && tc.Err {
+			t.Fatalf("Expected validation error for %q", tc.Value)
+		}
+	}
+}
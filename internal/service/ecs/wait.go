@@ -2,11 +2,15 @@ package ecs
 
 import (
 	"context"
+	"fmt"
+	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -19,6 +23,10 @@ const (
 	serviceDescribeTimeout    = 2 * time.Minute
 	serviceUpdateTimeout      = 2 * time.Minute
 
+	serviceDeploymentTimeout          = 10 * time.Minute
+	serviceDeploymentDiagnosticEvents = 5
+	serviceDeploymentDiagnosticTasks  = 5
+
 	clusterAvailableTimeout = 10 * time.Minute
 	clusterDeleteTimeout    = 10 * time.Minute
 	clusterAvailableDelay   = 10 * time.Second
@@ -77,6 +85,79 @@ func waitServiceStable(conn *ecs.ECS, id, cluster string) error {
 	return nil
 }
 
+// waitServiceDeploymentStable waits for the specified service deployment to complete.
+// On failure or timeout the returned error includes the deployment's rollout state reason,
+// the service's most recent events and the stopped reasons of the deployment's tasks.
+func waitServiceDeploymentStable(conn *ecs.ECS, id, cluster, deploymentID string, timeout time.Duration) (*ecs.Service, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ecs.DeploymentRolloutStateInProgress},
+		Target:  []string{ecs.DeploymentRolloutStateCompleted},
+		Refresh: statusServiceDeployment(conn, id, cluster, deploymentID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if err != nil {
+		tfresource.SetLastError(err, serviceDeploymentError(conn, id, cluster, deploymentID))
+	}
+
+	if output, ok := outputRaw.(*ecs.Service); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// serviceDeploymentError returns an error describing why the specified service deployment has not completed.
+func serviceDeploymentError(conn *ecs.ECS, id, cluster, deploymentID string) error {
+	service, err := FindServiceByIDAndCluster(conn, id, cluster)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to describe ECS Service (%s) for deployment (%s) diagnostics: %s", id, deploymentID, err)
+
+		return nil
+	}
+
+	var errs *multierror.Error
+
+	if deployment := findServiceDeploymentByID(service, deploymentID); deployment == nil {
+		errs = multierror.Append(errs, fmt.Errorf("deployment (%s) no longer exists", deploymentID))
+	} else if aws.StringValue(deployment.RolloutState) == ecs.DeploymentRolloutStateFailed {
+		errs = multierror.Append(errs, fmt.Errorf("deployment (%s) failed: %s", deploymentID, aws.StringValue(deployment.RolloutStateReason)))
+
+		if primary := findServicePrimaryDeployment(service); primary != nil && aws.StringValue(primary.Id) != deploymentID {
+			errs = multierror.Append(errs, fmt.Errorf("deployment circuit breaker rolled back to deployment (%s)", aws.StringValue(primary.Id)))
+		}
+	}
+
+	for i, event := range service.Events {
+		if i == serviceDeploymentDiagnosticEvents {
+			break
+		}
+
+		errs = multierror.Append(errs, fmt.Errorf("service event (%s): %s", aws.TimeValue(event.CreatedAt).Format(time.RFC3339), aws.StringValue(event.Message)))
+	}
+
+	tasks, err := FindServiceDeploymentStoppedTasks(conn, cluster, deploymentID, serviceDeploymentDiagnosticTasks)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to list stopped ECS Tasks for deployment (%s) diagnostics: %s", deploymentID, err)
+	}
+
+	for _, task := range tasks {
+		errs = multierror.Append(errs, fmt.Errorf("task (%s) stopped: %s", aws.StringValue(task.TaskArn), aws.StringValue(task.StoppedReason)))
+
+		for _, container := range task.Containers {
+			if reason := aws.StringValue(container.Reason); reason != "" {
+				errs = multierror.Append(errs, fmt.Errorf("task (%s) container (%s): %s", aws.StringValue(task.TaskArn), aws.StringValue(container.Name), reason))
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
 func waitServiceInactive(conn *ecs.ECS, id, cluster string) error {
 	input := &ecs.DescribeServicesInput{
 		Services: aws.StringSlice([]string{id}),
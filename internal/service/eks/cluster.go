@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -38,6 +39,7 @@ func ResourceCluster() *schema.Resource {
 				// You cannot disable envelope encryption after enabling it. This action is irreversible.
 				return len(old.([]interface{})) == 1 && len(new.([]interface{})) == 0
 			}),
+			resourceClusterCustomizeDiff,
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -63,6 +65,10 @@ func ResourceCluster() *schema.Resource {
 					},
 				},
 			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -154,6 +160,10 @@ func ResourceCluster() *schema.Resource {
 								validation.StringMatch(regexp.MustCompile(`^(10|172\.(1[6-9]|2[0-9]|3[0-1])|192\.168)\..*`), "must be within 10.0.0.0/8, 172.16.0.0/12, or 192.168.0.0/16"),
 							),
 						},
+						"service_ipv6_cidr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -163,6 +173,48 @@ func ResourceCluster() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validClusterName,
 			},
+			"outpost_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"control_plane_instance_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"control_plane_placement": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"outpost_arns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							// Only a single Outpost is supported.
+							MaxItems: 1,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+			},
 			"platform_version": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -256,6 +308,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		input.KubernetesNetworkConfig = expandEksNetworkConfigRequest(d.Get("kubernetes_network_config").([]interface{}))
 	}
 
+	if v, ok := d.GetOk("outpost_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OutpostConfig = expandEksOutpostConfigRequest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("version"); ok {
 		input.Version = aws.String(v.(string))
 	}
@@ -344,6 +400,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting certificate_authority: %w", err)
 	}
 
+	d.Set("cluster_id", cluster.Id)
 	d.Set("created_at", aws.TimeValue(cluster.CreatedAt).String())
 
 	if err := d.Set("enabled_cluster_log_types", flattenEksEnabledLogTypes(cluster.Logging)); err != nil {
@@ -365,6 +422,15 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("name", cluster.Name)
+
+	if cluster.OutpostConfig != nil {
+		if err := d.Set("outpost_config", []interface{}{flattenEksOutpostConfigResponse(cluster.OutpostConfig)}); err != nil {
+			return fmt.Errorf("error setting outpost_config: %w", err)
+		}
+	} else {
+		d.Set("outpost_config", nil)
+	}
+
 	d.Set("platform_version", cluster.PlatformVersion)
 	d.Set("role_arn", cluster.RoleArn)
 	d.Set("status", cluster.Status)
@@ -414,29 +480,10 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("encryption_config") {
-		o, n := d.GetChange("encryption_config")
-
-		if len(o.([]interface{})) == 0 && len(n.([]interface{})) == 1 {
-			input := &eks.AssociateEncryptionConfigInput{
-				ClusterName:      aws.String(d.Id()),
-				EncryptionConfig: expandEksEncryptionConfig(d.Get("encryption_config").([]interface{})),
-			}
-
-			log.Printf("[DEBUG] Associating EKS Cluster (%s) encryption config: %s", d.Id(), input)
-			output, err := conn.AssociateEncryptionConfig(input)
-
-			if err != nil {
-				return fmt.Errorf("error associating EKS Cluster (%s) encryption config: %w", d.Id(), err)
-			}
-
-			updateID := aws.StringValue(output.Update.Id)
-
-			_, err = waitClusterUpdateSuccessful(conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
-
-			if err != nil {
-				return fmt.Errorf("error waiting for EKS Cluster (%s) encryption config association (%s): %w", d.Id(), updateID, err)
-			}
+	// Envelope encryption can only be enabled in-place. Other changes force a new resource.
+	if o, n := d.GetChange("encryption_config"); len(o.([]interface{})) == 0 && len(n.([]interface{})) == 1 {
+		if err := associateClusterEncryptionConfig(conn, d.Id(), d.Get("encryption_config").([]interface{}), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
 		}
 	}
 
@@ -547,6 +594,59 @@ func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// resourceClusterCustomizeDiff validates combinations of arguments that the API would only reject when the cluster is created.
+func resourceClusterCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Envelope encryption can be enabled in-place, but the key and resources can't be changed after enabling it.
+	if diff.Id() != "" && diff.HasChange("encryption_config") {
+		if o, n := diff.GetChange("encryption_config"); len(o.([]interface{})) == 1 && len(n.([]interface{})) == 1 {
+			if err := diff.ForceNew("encryption_config"); err != nil {
+				return err
+			}
+		}
+	}
+
+	ipFamily := diff.Get("kubernetes_network_config.0.ip_family").(string)
+
+	if ipFamily != eks.IpFamilyIpv6 {
+		return nil
+	}
+
+	// service_ipv4_cidr is computed, so check whether it's configured rather than its planned value.
+	if v := diff.GetRawConfig().GetAttr("kubernetes_network_config"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		if v := v.Index(cty.NumberIntVal(0)).GetAttr("service_ipv4_cidr"); v.IsKnown() && !v.IsNull() {
+			return fmt.Errorf("kubernetes_network_config.0.service_ipv4_cidr can't be configured with the %s IP family; the service CIDR is assigned by EKS", ipFamily)
+		}
+	}
+
+	if v, ok := diff.GetOk("outpost_config"); ok && len(v.([]interface{})) > 0 {
+		return fmt.Errorf("clusters on Outposts don't support the %s IP family", ipFamily)
+	}
+
+	return nil
+}
+
+func associateClusterEncryptionConfig(conn *eks.EKS, name string, tfList []interface{}, timeout time.Duration) error {
+	input := &eks.AssociateEncryptionConfigInput{
+		ClusterName:      aws.String(name),
+		EncryptionConfig: expandEksEncryptionConfig(tfList),
+	}
+
+	log.Printf("[DEBUG] Associating EKS Cluster (%s) encryption config: %s", name, input)
+	output, err := conn.AssociateEncryptionConfig(input)
+
+	if err != nil {
+		return fmt.Errorf("error associating EKS Cluster (%s) encryption config: %w", name, err)
+	}
+
+	updateID := aws.StringValue(output.Update.Id)
+
+	if _, err := waitClusterEncryptionConfigAssociated(conn, name, updateID, timeout); err != nil {
+		return fmt.Errorf("error waiting for EKS Cluster (%s) encryption config association (%s): %w", name, updateID, err)
+	}
+
+	return nil
+}
+
 func expandEksEncryptionConfig(tfList []interface{}) []*eks.EncryptionConfig {
 	if len(tfList) == 0 {
 		return nil
@@ -651,6 +751,42 @@ func expandEksNetworkConfigRequest(tfList []interface{}) *eks.KubernetesNetworkC
 	return apiObject
 }
 
+func expandEksOutpostConfigRequest(tfMap map[string]interface{}) *eks.OutpostConfigRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &eks.OutpostConfigRequest{}
+
+	if v, ok := tfMap["control_plane_instance_type"].(string); ok && v != "" {
+		apiObject.ControlPlaneInstanceType = aws.String(v)
+	}
+
+	if v, ok := tfMap["control_plane_placement"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ControlPlanePlacement = expandEksControlPlanePlacementRequest(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["outpost_arns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.OutpostArns = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandEksControlPlanePlacementRequest(tfMap map[string]interface{}) *eks.ControlPlanePlacementRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &eks.ControlPlanePlacementRequest{}
+
+	if v, ok := tfMap["group_name"].(string); ok && v != "" {
+		apiObject.GroupName = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func expandEksLoggingTypes(vEnabledLogTypes *schema.Set) *eks.Logging {
 	vEksLogTypes := []interface{}{}
 	for _, eksLogType := range eks.LogType_Values() {
@@ -781,8 +917,38 @@ func flattenEksNetworkConfig(apiObject *eks.KubernetesNetworkConfigResponse) []i
 
 	tfMap := map[string]interface{}{
 		"service_ipv4_cidr": aws.StringValue(apiObject.ServiceIpv4Cidr),
+		"service_ipv6_cidr": aws.StringValue(apiObject.ServiceIpv6Cidr),
 		"ip_family":         aws.StringValue(apiObject.IpFamily),
 	}
 
 	return []interface{}{tfMap}
 }
+
+func flattenEksOutpostConfigResponse(apiObject *eks.OutpostConfigResponse) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"control_plane_instance_type": aws.StringValue(apiObject.ControlPlaneInstanceType),
+		"outpost_arns":                aws.StringValueSlice(apiObject.OutpostArns),
+	}
+
+	if v := apiObject.ControlPlanePlacement; v != nil {
+		tfMap["control_plane_placement"] = []interface{}{flattenEksControlPlanePlacementResponse(v)}
+	}
+
+	return tfMap
+}
+
+func flattenEksControlPlanePlacementResponse(apiObject *eks.ControlPlanePlacementResponse) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"group_name": aws.StringValue(apiObject.GroupName),
+	}
+
+	return tfMap
+}
@@ -0,0 +1,201 @@
+package eks
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceClusterKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceClusterKubeconfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"auth_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      KubeconfigAuthMethodExec,
+				ValidateFunc: validation.StringInSlice(KubeconfigAuthMethod_Values(), false),
+			},
+			"cluster": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"certificate_authority_data": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"endpoint": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validClusterName,
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"current_context": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"exec_api_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  kubeconfigDefaultExecAPIVersion,
+			},
+			"exec_command": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  kubeconfigDefaultExecCommand,
+			},
+			"exec_env": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"kubeconfig": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceClusterKubeconfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*conns.AWSClient)
+	authMethod := d.Get("auth_method").(string)
+
+	var clusters []kubeconfigCluster
+	tfList := d.Get("cluster").([]interface{})
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		cluster := kubeconfigCluster{
+			Alias:                    tfMap["alias"].(string),
+			CertificateAuthorityData: tfMap["certificate_authority_data"].(string),
+			Endpoint:                 tfMap["endpoint"].(string),
+			Name:                     tfMap["name"].(string),
+			Namespace:                tfMap["namespace"].(string),
+			RoleARN:                  tfMap["role_arn"].(string),
+		}
+
+		// The cluster is only described if its connection details aren't configured.
+		if cluster.Endpoint == "" || cluster.CertificateAuthorityData == "" {
+			output, err := FindClusterByName(client.EKSConn, cluster.Name)
+
+			if err != nil {
+				return fmt.Errorf("error reading EKS Cluster (%s): %w", cluster.Name, err)
+			}
+
+			if cluster.Endpoint == "" {
+				cluster.Endpoint = aws.StringValue(output.Endpoint)
+			}
+
+			if cluster.CertificateAuthorityData == "" && output.CertificateAuthority != nil {
+				cluster.CertificateAuthorityData = aws.StringValue(output.CertificateAuthority.Data)
+			}
+
+			tfMap["certificate_authority_data"] = cluster.CertificateAuthorityData
+			tfMap["endpoint"] = cluster.Endpoint
+		}
+
+		if authMethod == KubeconfigAuthMethodToken {
+			token, err := clusterKubeconfigToken(client, cluster)
+
+			if err != nil {
+				return err
+			}
+
+			cluster.Token = token
+		}
+
+		clusters = append(clusters, cluster)
+	}
+
+	kubeconfig, err := renderKubeconfig(clusters, kubeconfigOptions{
+		AuthMethod:     authMethod,
+		CurrentContext: d.Get("current_context").(string),
+		ExecAPIVersion: d.Get("exec_api_version").(string),
+		ExecCommand:    d.Get("exec_command").(string),
+		ExecEnv:        aws.StringValueMap(flex.ExpandStringMap(d.Get("exec_env").(map[string]interface{}))),
+		Region:         client.Region,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error rendering kubeconfig: %w", err)
+	}
+
+	// Tokens are short-lived, so the ID doesn't depend on the rendered document.
+	var id string
+	for _, cluster := range clusters {
+		id += cluster.Name + "," + cluster.Alias + "," + cluster.RoleARN + ";"
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(id)))
+	if err := d.Set("cluster", tfList); err != nil {
+		return fmt.Errorf("error setting cluster: %w", err)
+	}
+	d.Set("kubeconfig", kubeconfig)
+
+	return nil
+}
+
+// clusterKubeconfigToken returns a bearer token for the cluster, assuming the cluster's role if one is configured.
+func clusterKubeconfigToken(client *conns.AWSClient, cluster kubeconfigCluster) (string, error) {
+	conn := client.STSConn
+
+	if cluster.RoleARN != "" {
+		conn = sts.New(client.Session, &aws.Config{
+			Credentials: stscreds.NewCredentials(client.Session, cluster.RoleARN),
+		})
+	}
+
+	generator, err := NewGenerator(false, false)
+
+	if err != nil {
+		return "", fmt.Errorf("error getting token generator: %w", err)
+	}
+
+	token, err := generator.GetWithSTS(cluster.Name, conn)
+
+	if err != nil {
+		return "", fmt.Errorf("error getting token for EKS Cluster (%s): %w", cluster.Name, err)
+	}
+
+	return token.Token, nil
+}
@@ -0,0 +1,121 @@
+package eks_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/eks"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEKSClusterKubeconfigDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceResourceName := "data.aws_eks_cluster_kubeconfig.test"
+	resourceName := "aws_eks_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, eks.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterKubeconfigDataSourceConfig_Basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceResourceName, "cluster.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority.0.data", dataSourceResourceName, "cluster.0.certificate_authority_data"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint", dataSourceResourceName, "cluster.0.endpoint"),
+					resource.TestMatchResourceAttr(dataSourceResourceName, "kubeconfig", regexp.MustCompile(`get-token`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSClusterKubeconfigDataSource_offline(t *testing.T) {
+	dataSourceResourceName := "data.aws_eks_cluster_kubeconfig.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, eks.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterKubeconfigDataSourceConfig_Offline,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceResourceName, "cluster.#", "2"),
+					resource.TestMatchResourceAttr(dataSourceResourceName, "kubeconfig", regexp.MustCompile(`current-context: dev`)),
+					resource.TestMatchResourceAttr(dataSourceResourceName, "kubeconfig", regexp.MustCompile(`--role-arn`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSClusterKubeconfigDataSource_duplicateAlias(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, eks.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterKubeconfigDataSourceConfig_DuplicateAlias,
+				ExpectError: regexp.MustCompile(`duplicate cluster alias \(example\)`),
+			},
+		},
+	})
+}
+
+func testAccClusterKubeconfigDataSourceConfig_Basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_Required(rName), `
+data "aws_eks_cluster_kubeconfig" "test" {
+  cluster {
+    name = aws_eks_cluster.test.name
+  }
+}
+`)
+}
+
+const testAccClusterKubeconfigDataSourceConfig_Offline = `
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_eks_cluster_kubeconfig" "test" {
+  current_context = "dev"
+
+  cluster {
+    alias                      = "prod"
+    certificate_authority_data = "UFJPRCBDQQ=="
+    endpoint                   = "https://PROD.gr7.us-west-2.eks.amazonaws.com"
+    name                       = "production"
+    namespace                  = "apps"
+    role_arn                   = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/admin"
+  }
+
+  cluster {
+    alias                      = "dev"
+    certificate_authority_data = "REVWIENB"
+    endpoint                   = "https://DEV.gr7.us-west-2.eks.amazonaws.com"
+    name                       = "development"
+  }
+}
+`
+
+const testAccClusterKubeconfigDataSourceConfig_DuplicateAlias = `
+data "aws_eks_cluster_kubeconfig" "test" {
+  cluster {
+    certificate_authority_data = "Q0EgREFUQQ=="
+    endpoint                   = "https://EXAMPLE.gr7.us-west-2.eks.amazonaws.com"
+    name                       = "example"
+  }
+
+  cluster {
+    certificate_authority_data = "Q0EgREFUQQ=="
+    endpoint                   = "https://EXAMPLE.gr7.us-west-2.eks.amazonaws.com"
+    name                       = "example"
+  }
+}
+`
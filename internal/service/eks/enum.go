@@ -4,6 +4,18 @@ const (
 	IdentityProviderConfigTypeOIDC = "oidc"
 )
 
+const (
+	KubeconfigAuthMethodExec  = "exec"
+	KubeconfigAuthMethodToken = "token"
+)
+
+func KubeconfigAuthMethod_Values() []string {
+	return []string{
+		KubeconfigAuthMethodExec,
+		KubeconfigAuthMethodToken,
+	}
+}
+
 const (
 	ResourcesSecrets = "secrets"
 )
@@ -0,0 +1,230 @@
+package eks
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	kubeconfigDefaultExecAPIVersion = "client.authentication.k8s.io/v1beta1"
+	kubeconfigDefaultExecCommand    = "aws"
+)
+
+// kubeconfigCluster is one EKS cluster to add to a kubeconfig document.
+type kubeconfigCluster struct {
+	// Alias names the cluster, context and user entries. Defaults to Name.
+	Alias                    string
+	CertificateAuthorityData string
+	Endpoint                 string
+	Name                     string
+	Namespace                string
+	RoleARN                  string
+	// Token is used when rendering with the token auth method.
+	Token string
+}
+
+type kubeconfigOptions struct {
+	AuthMethod string
+	// CurrentContext defaults to the first cluster's alias.
+	CurrentContext string
+	ExecAPIVersion string
+	ExecCommand    string
+	ExecEnv        map[string]string
+	Region         string
+}
+
+// The following types mirror the kubeconfig file format. Fields are in the order kubectl writes them.
+
+type kubeconfigDocument struct {
+	APIVersion     string                   `yaml:"apiVersion"`
+	Clusters       []kubeconfigNamedCluster `yaml:"clusters"`
+	Contexts       []kubeconfigNamedContext `yaml:"contexts"`
+	CurrentContext string                   `yaml:"current-context"`
+	Kind           string                   `yaml:"kind"`
+	Preferences    struct{}                 `yaml:"preferences"`
+	Users          []kubeconfigNamedUser    `yaml:"users"`
+}
+
+type kubeconfigNamedCluster struct {
+	Cluster kubeconfigClusterEntry `yaml:"cluster"`
+	Name    string                 `yaml:"name"`
+}
+
+type kubeconfigClusterEntry struct {
+	CertificateAuthorityData string `yaml:"certificate-authority-data"`
+	Server                   string `yaml:"server"`
+}
+
+type kubeconfigNamedContext struct {
+	Context kubeconfigContextEntry `yaml:"context"`
+	Name    string                 `yaml:"name"`
+}
+
+type kubeconfigContextEntry struct {
+	Cluster   string `yaml:"cluster"`
+	Namespace string `yaml:"namespace,omitempty"`
+	User      string `yaml:"user"`
+}
+
+type kubeconfigNamedUser struct {
+	Name string              `yaml:"name"`
+	User kubeconfigUserEntry `yaml:"user"`
+}
+
+type kubeconfigUserEntry struct {
+	Exec  *kubeconfigExec `yaml:"exec,omitempty"`
+	Token string          `yaml:"token,omitempty"`
+}
+
+type kubeconfigExec struct {
+	APIVersion      string                 `yaml:"apiVersion"`
+	Args            []string               `yaml:"args"`
+	Command         string                 `yaml:"command"`
+	Env             []kubeconfigExecEnvVar `yaml:"env,omitempty"`
+	InteractiveMode string                 `yaml:"interactiveMode,omitempty"`
+}
+
+type kubeconfigExecEnvVar struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+// renderKubeconfig renders a kubeconfig document with one cluster, context and user for each cluster.
+func renderKubeconfig(clusters []kubeconfigCluster, opts kubeconfigOptions) (string, error) {
+	if len(clusters) == 0 {
+		return "", fmt.Errorf("at least one cluster is required")
+	}
+
+	authMethod := opts.AuthMethod
+	if authMethod == "" {
+		authMethod = KubeconfigAuthMethodExec
+	}
+
+	doc := kubeconfigDocument{
+		APIVersion:     "v1",
+		CurrentContext: opts.CurrentContext,
+		Kind:           "Config",
+	}
+
+	aliases := make(map[string]struct{})
+
+	for _, cluster := range clusters {
+		alias := cluster.Alias
+		if alias == "" {
+			alias = cluster.Name
+		}
+
+		if alias == "" {
+			return "", fmt.Errorf("cluster name is required")
+		}
+
+		if _, ok := aliases[alias]; ok {
+			return "", fmt.Errorf("duplicate cluster alias (%s)", alias)
+		}
+
+		aliases[alias] = struct{}{}
+
+		if cluster.Endpoint == "" {
+			return "", fmt.Errorf("cluster (%s) endpoint is required", alias)
+		}
+
+		if doc.CurrentContext == "" {
+			doc.CurrentContext = alias
+		}
+
+		doc.Clusters = append(doc.Clusters, kubeconfigNamedCluster{
+			Cluster: kubeconfigClusterEntry{
+				CertificateAuthorityData: cluster.CertificateAuthorityData,
+				Server:                   cluster.Endpoint,
+			},
+			Name: alias,
+		})
+
+		doc.Contexts = append(doc.Contexts, kubeconfigNamedContext{
+			Context: kubeconfigContextEntry{
+				Cluster:   alias,
+				Namespace: cluster.Namespace,
+				User:      alias,
+			},
+			Name: alias,
+		})
+
+		var user kubeconfigUserEntry
+
+		switch authMethod {
+		case KubeconfigAuthMethodExec:
+			user.Exec = kubeconfigExecEntry(cluster, opts)
+		case KubeconfigAuthMethodToken:
+			if cluster.Token == "" {
+				return "", fmt.Errorf("cluster (%s) token is required with the %s auth method", alias, authMethod)
+			}
+
+			user.Token = cluster.Token
+		default:
+			return "", fmt.Errorf("unsupported auth method (%s)", authMethod)
+		}
+
+		doc.Users = append(doc.Users, kubeconfigNamedUser{
+			Name: alias,
+			User: user,
+		})
+	}
+
+	if _, ok := aliases[doc.CurrentContext]; !ok {
+		return "", fmt.Errorf("current context (%s) does not refer to a cluster alias", doc.CurrentContext)
+	}
+
+	b, err := yaml.Marshal(doc)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// kubeconfigExecEntry returns the same exec configuration as `aws eks update-kubeconfig`.
+func kubeconfigExecEntry(cluster kubeconfigCluster, opts kubeconfigOptions) *kubeconfigExec {
+	exec := &kubeconfigExec{
+		APIVersion: opts.ExecAPIVersion,
+		Command:    opts.ExecCommand,
+	}
+
+	if exec.APIVersion == "" {
+		exec.APIVersion = kubeconfigDefaultExecAPIVersion
+	}
+
+	if exec.Command == "" {
+		exec.Command = kubeconfigDefaultExecCommand
+	}
+
+	// client.authentication.k8s.io/v1 requires interactiveMode.
+	if strings.HasSuffix(exec.APIVersion, "/v1") {
+		exec.InteractiveMode = "Never"
+	}
+
+	if opts.Region != "" {
+		exec.Args = append(exec.Args, "--region", opts.Region)
+	}
+
+	exec.Args = append(exec.Args, "eks", "get-token", "--cluster-name", cluster.Name)
+
+	if cluster.RoleARN != "" {
+		exec.Args = append(exec.Args, "--role-arn", cluster.RoleARN)
+	}
+
+	names := make([]string, 0, len(opts.ExecEnv))
+	for name := range opts.ExecEnv {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		exec.Env = append(exec.Env, kubeconfigExecEnvVar{Name: name, Value: opts.ExecEnv[name]})
+	}
+
+	return exec
+}
@@ -0,0 +1,177 @@
+package eks
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var updateKubeconfigGolden = flag.Bool("update", false, "update kubeconfig golden files")
+
+func TestRenderKubeconfig(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Clusters []kubeconfigCluster
+		Options  kubeconfigOptions
+		Golden   string
+	}{
+		{
+			Name: "exec",
+			Clusters: []kubeconfigCluster{
+				{
+					CertificateAuthorityData: "Q0EgREFUQQ==",
+					Endpoint:                 "https://EXAMPLE.gr7.us-west-2.eks.amazonaws.com",
+					Name:                     "example",
+				},
+			},
+			Options: kubeconfigOptions{
+				Region: "us-west-2",
+			},
+			Golden: "exec.yaml",
+		},
+		{
+			Name: "multiple clusters",
+			Clusters: []kubeconfigCluster{
+				{
+					Alias:                    "prod",
+					CertificateAuthorityData: "UFJPRCBDQQ==",
+					Endpoint:                 "https://PROD.gr7.us-west-2.eks.amazonaws.com",
+					Name:                     "production",
+					Namespace:                "apps",
+					RoleARN:                  "arn:aws:iam::123456789012:role/admin",
+				},
+				{
+					Alias:                    "dev",
+					CertificateAuthorityData: "REVWIENB",
+					Endpoint:                 "https://DEV.gr7.us-west-2.eks.amazonaws.com",
+					Name:                     "development",
+				},
+			},
+			Options: kubeconfigOptions{
+				CurrentContext: "dev",
+				ExecEnv: map[string]string{
+					"AWS_PROFILE":      "example",
+					"AWS_STS_REGIONAL": "regional",
+				},
+				Region: "us-west-2",
+			},
+			Golden: "multiple_clusters.yaml",
+		},
+		{
+			Name: "exec v1",
+			Clusters: []kubeconfigCluster{
+				{
+					CertificateAuthorityData: "Q0EgREFUQQ==",
+					Endpoint:                 "https://EXAMPLE.gr7.us-west-2.eks.amazonaws.com",
+					Name:                     "example",
+				},
+			},
+			Options: kubeconfigOptions{
+				ExecAPIVersion: "client.authentication.k8s.io/v1",
+				ExecCommand:    "/usr/local/bin/aws",
+				Region:         "us-west-2",
+			},
+			Golden: "exec_v1.yaml",
+		},
+		{
+			Name: "token",
+			Clusters: []kubeconfigCluster{
+				{
+					CertificateAuthorityData: "Q0EgREFUQQ==",
+					Endpoint:                 "https://EXAMPLE.gr7.us-west-2.eks.amazonaws.com",
+					Name:                     "example",
+					Token:                    "k8s-aws-v1.EXAMPLE",
+				},
+			},
+			Options: kubeconfigOptions{
+				AuthMethod: KubeconfigAuthMethodToken,
+			},
+			Golden: "token.yaml",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := renderKubeconfig(testCase.Clusters, testCase.Options)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			path := filepath.Join("testdata", "kubeconfig", testCase.Golden)
+
+			if *updateKubeconfigGolden {
+				if err := os.WriteFile(path, []byte(got), 0644); err != nil {
+					t.Fatalf("error writing golden file: %s", err)
+				}
+			}
+
+			want, err := os.ReadFile(path)
+
+			if err != nil {
+				t.Fatalf("error reading golden file: %s", err)
+			}
+
+			if got != string(want) {
+				t.Errorf("kubeconfig does not match %s\ngot:\n%s\nwant:\n%s", path, got, want)
+			}
+		})
+	}
+}
+
+func TestRenderKubeconfigErrors(t *testing.T) {
+	cluster := kubeconfigCluster{
+		CertificateAuthorityData: "Q0EgREFUQQ==",
+		Endpoint:                 "https://EXAMPLE.gr7.us-west-2.eks.amazonaws.com",
+		Name:                     "example",
+	}
+
+	testCases := []struct {
+		Name     string
+		Clusters []kubeconfigCluster
+		Options  kubeconfigOptions
+		Expected string
+	}{
+		{
+			Name:     "no clusters",
+			Expected: "at least one cluster is required",
+		},
+		{
+			Name:     "duplicate alias",
+			Clusters: []kubeconfigCluster{cluster, cluster},
+			Expected: "duplicate cluster alias (example)",
+		},
+		{
+			Name:     "missing endpoint",
+			Clusters: []kubeconfigCluster{{Name: "example"}},
+			Expected: "cluster (example) endpoint is required",
+		},
+		{
+			Name:     "missing token",
+			Clusters: []kubeconfigCluster{cluster},
+			Options:  kubeconfigOptions{AuthMethod: KubeconfigAuthMethodToken},
+			Expected: "cluster (example) token is required with the token auth method",
+		},
+		{
+			Name:     "unknown current context",
+			Clusters: []kubeconfigCluster{cluster},
+			Options:  kubeconfigOptions{CurrentContext: "other"},
+			Expected: "current context (other) does not refer to a cluster alias",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := renderKubeconfig(testCase.Clusters, testCase.Options)
+
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+
+			if err.Error() != testCase.Expected {
+				t.Errorf("expected error %q, got %q", testCase.Expected, err)
+			}
+		})
+	}
+}
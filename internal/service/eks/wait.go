@@ -136,6 +136,35 @@ func waitClusterUpdateSuccessful(conn *eks.EKS, name, id string, timeout time.Du
 	return nil, err
 }
 
+// waitClusterEncryptionConfigAssociated waits for an AssociateEncryptionConfig update and then for the cluster to become active.
+// Secrets are re-encrypted after the update succeeds, during which the cluster is UPDATING.
+func waitClusterEncryptionConfigAssociated(conn *eks.EKS, name, id string, timeout time.Duration) (*eks.Cluster, error) {
+	start := time.Now()
+
+	if _, err := waitClusterUpdateSuccessful(conn, name, id, timeout); err != nil {
+		return nil, err
+	}
+
+	return waitClusterUpdated(conn, name, timeout-time.Since(start))
+}
+
+func waitClusterUpdated(conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eks.ClusterStatusUpdating},
+		Target:  []string{eks.ClusterStatusActive},
+		Refresh: statusCluster(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*eks.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitFargateProfileCreated(conn *eks.EKS, clusterName, fargateProfileName string, timeout time.Duration) (*eks.FargateProfile, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{eks.FargateProfileStatusCreating},
@@ -0,0 +1,703 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"strings"
+)
+
+// EventPattern is a compiled EventBridge event pattern that can be evaluated against events
+// without calling AWS. See https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-event-patterns.html.
+type EventPattern struct {
+	root *eventPatternNode
+}
+
+// eventPatternNode is one level of an event pattern: matchers for leaf fields, nested
+// patterns for object fields and the alternatives of an optional $or.
+type eventPatternNode struct {
+	leaves   map[string][]eventPatternMatcher
+	children map[string]*eventPatternNode
+	or       []*eventPatternNode
+}
+
+// eventPatternMatcher is one element of the array of values a leaf field can match.
+// The field matches if any of its matchers does.
+type eventPatternMatcher interface {
+	// match reports whether the matcher matches the field's values. A field that is
+	// missing from the event, or that is an empty array, has no values.
+	match(values []interface{}) bool
+}
+
+// ParseEventPattern compiles an event pattern, returning an error describing the first problem found.
+func ParseEventPattern(pattern string) (*EventPattern, error) {
+	v, err := decodeEventJSON(pattern)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	m, ok := v.(map[string]interface{})
+
+	if !ok || len(m) == 0 {
+		return nil, fmt.Errorf("event pattern must be a non-empty JSON object")
+	}
+
+	root, err := compileEventPatternNode("", m)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventPattern{root: root}, nil
+}
+
+// Matches reports whether the event, a JSON object, matches the pattern.
+func (p *EventPattern) Matches(event string) (bool, error) {
+	v, err := decodeEventJSON(event)
+
+	if err != nil {
+		return false, fmt.Errorf("invalid event JSON: %w", err)
+	}
+
+	if _, ok := v.(map[string]interface{}); !ok {
+		return false, fmt.Errorf("event must be a JSON object")
+	}
+
+	return p.root.match([]interface{}{v}), nil
+}
+
+func decodeEventJSON(s string) (interface{}, error) {
+	var v interface{}
+
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after top-level value")
+	}
+
+	return v, nil
+}
+
+func compileEventPatternNode(path string, m map[string]interface{}) (*eventPatternNode, error) {
+	node := &eventPatternNode{
+		leaves:   make(map[string][]eventPatternMatcher),
+		children: make(map[string]*eventPatternNode),
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		fieldPath := joinEventPatternPath(path, k)
+
+		switch v := m[k].(type) {
+		case map[string]interface{}:
+			if len(v) == 0 {
+				return nil, fmt.Errorf("%s: must be a non-empty object or array", fieldPath)
+			}
+
+			child, err := compileEventPatternNode(fieldPath, v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			node.children[k] = child
+
+		case []interface{}:
+			if k == "$or" {
+				if len(v) < 2 {
+					return nil, fmt.Errorf("%s: must contain at least 2 patterns", fieldPath)
+				}
+
+				for i, alt := range v {
+					altMap, ok := alt.(map[string]interface{})
+
+					if !ok || len(altMap) == 0 {
+						return nil, fmt.Errorf("%s[%d]: must be a non-empty object", fieldPath, i)
+					}
+
+					altNode, err := compileEventPatternNode(path, altMap)
+
+					if err != nil {
+						return nil, err
+					}
+
+					node.or = append(node.or, altNode)
+				}
+
+				continue
+			}
+
+			if len(v) == 0 {
+				return nil, fmt.Errorf("%s: must be a non-empty array", fieldPath)
+			}
+
+			for i, item := range v {
+				matcher, err := compileEventPatternMatcher(item)
+
+				if err != nil {
+					return nil, fmt.Errorf("%s[%d]: %w", fieldPath, i, err)
+				}
+
+				node.leaves[k] = append(node.leaves[k], matcher)
+			}
+
+		default:
+			return nil, fmt.Errorf("%s: must be an object or an array, got %s", fieldPath, eventValueType(v))
+		}
+	}
+
+	return node, nil
+}
+
+func compileEventPatternMatcher(v interface{}) (eventPatternMatcher, error) {
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return compileEventPatternLiteral(v)
+	}
+
+	if len(m) != 1 {
+		return nil, fmt.Errorf("content filter must have exactly one key, got %d", len(m))
+	}
+
+	for k, v := range m {
+		switch k {
+		case "anything-but":
+			return compileAnythingButMatcher(v)
+		case "cidr":
+			s, ok := v.(string)
+
+			if !ok {
+				return nil, fmt.Errorf("cidr must be a string")
+			}
+
+			_, ipNet, err := net.ParseCIDR(s)
+
+			if err != nil {
+				return nil, fmt.Errorf("cidr: %w", err)
+			}
+
+			return cidrMatcher{ipNet: ipNet}, nil
+		case "equals-ignore-case":
+			s, ok := v.(string)
+
+			if !ok {
+				return nil, fmt.Errorf("equals-ignore-case must be a string")
+			}
+
+			return stringMatcher{value: s, compare: strings.EqualFold}, nil
+		case "exists":
+			b, ok := v.(bool)
+
+			if !ok {
+				return nil, fmt.Errorf("exists must be true or false")
+			}
+
+			return existsMatcher(b), nil
+		case "numeric":
+			return compileNumericMatcher(v)
+		case "prefix", "suffix":
+			return compileAffixMatcher(k, v)
+		case "wildcard":
+			s, ok := v.(string)
+
+			if !ok {
+				return nil, fmt.Errorf("wildcard must be a string")
+			}
+
+			if strings.Contains(s, "**") {
+				return nil, fmt.Errorf("wildcard cannot contain consecutive * characters")
+			}
+
+			return stringMatcher{value: s, compare: wildcardMatch}, nil
+		default:
+			return nil, fmt.Errorf("unknown content filter %q", k)
+		}
+	}
+
+	return nil, nil // unreachable
+}
+
+func compileEventPatternLiteral(v interface{}) (eventPatternMatcher, error) {
+	switch v := v.(type) {
+	case nil, bool, string:
+		return literalMatcher{value: v}, nil
+	case json.Number:
+		f, err := v.Float64()
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s", v)
+		}
+
+		return literalMatcher{value: f}, nil
+	default:
+		return nil, fmt.Errorf("must be a string, number, boolean, null or content filter, got %s", eventValueType(v))
+	}
+}
+
+func compileAffixMatcher(op string, v interface{}) (eventPatternMatcher, error) {
+	compare := strings.HasPrefix
+	if op == "suffix" {
+		compare = strings.HasSuffix
+	}
+
+	switch v := v.(type) {
+	case string:
+		return stringMatcher{value: v, compare: func(s, affix string) bool { return compare(s, affix) }}, nil
+	case map[string]interface{}:
+		s, ok := v["equals-ignore-case"].(string)
+
+		if !ok || len(v) != 1 {
+			return nil, fmt.Errorf("%s must be a string or an object with a single equals-ignore-case string", op)
+		}
+
+		return stringMatcher{value: s, compare: func(s, affix string) bool { return compare(strings.ToLower(s), strings.ToLower(affix)) }}, nil
+	default:
+		return nil, fmt.Errorf("%s must be a string or an object with a single equals-ignore-case string", op)
+	}
+}
+
+func compileAnythingButMatcher(v interface{}) (eventPatternMatcher, error) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if len(v) != 1 {
+			return nil, fmt.Errorf("anything-but content filter must have exactly one key, got %d", len(v))
+		}
+
+		for k, v := range v {
+			switch k {
+			case "prefix", "suffix":
+				if _, ok := v.(string); !ok {
+					return nil, fmt.Errorf("anything-but %s must be a string", k)
+				}
+
+				inner, err := compileAffixMatcher(k, v)
+
+				if err != nil {
+					return nil, err
+				}
+
+				return anythingButMatcher{inner: []eventPatternMatcher{inner}}, nil
+			case "equals-ignore-case":
+				var l []interface{}
+
+				switch v := v.(type) {
+				case string:
+					l = []interface{}{v}
+				case []interface{}:
+					l = v
+				}
+
+				if len(l) == 0 {
+					return nil, fmt.Errorf("anything-but equals-ignore-case must be a string or a non-empty array of strings")
+				}
+
+				var inner []eventPatternMatcher
+
+				for _, item := range l {
+					s, ok := item.(string)
+
+					if !ok {
+						return nil, fmt.Errorf("anything-but equals-ignore-case must be a string or a non-empty array of strings")
+					}
+
+					inner = append(inner, stringMatcher{value: s, compare: strings.EqualFold})
+				}
+
+				return anythingButMatcher{inner: inner}, nil
+			default:
+				return nil, fmt.Errorf("unsupported anything-but content filter %q", k)
+			}
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			return nil, fmt.Errorf("anything-but must not be an empty array")
+		}
+
+		var inner []eventPatternMatcher
+
+		for _, item := range v {
+			switch item.(type) {
+			case string, json.Number:
+			default:
+				return nil, fmt.Errorf("anything-but array must contain only strings or numbers")
+			}
+
+			m, err := compileEventPatternLiteral(item)
+
+			if err != nil {
+				return nil, err
+			}
+
+			inner = append(inner, m)
+		}
+
+		return anythingButMatcher{inner: inner}, nil
+	case string, json.Number:
+		m, err := compileEventPatternLiteral(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return anythingButMatcher{inner: []eventPatternMatcher{m}}, nil
+	}
+
+	return nil, fmt.Errorf("anything-but must be a string, number, array or content filter")
+}
+
+func compileNumericMatcher(v interface{}) (eventPatternMatcher, error) {
+	l, ok := v.([]interface{})
+
+	if !ok || (len(l) != 2 && len(l) != 4) {
+		return nil, fmt.Errorf("numeric must be an array of one or two operator and value pairs")
+	}
+
+	var matcher numericMatcher
+
+	for i := 0; i < len(l); i += 2 {
+		op, ok := l[i].(string)
+
+		if !ok {
+			return nil, fmt.Errorf("numeric operator must be a string")
+		}
+
+		n, ok := l[i+1].(json.Number)
+
+		if !ok {
+			return nil, fmt.Errorf("numeric operator %s must be followed by a number", op)
+		}
+
+		f, err := n.Float64()
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s", n)
+		}
+
+		switch op {
+		case "=", "<", "<=", ">", ">=":
+		default:
+			return nil, fmt.Errorf("unknown numeric operator %q", op)
+		}
+
+		if len(l) == 4 && (op == "=" || (i == 0) != strings.HasPrefix(op, ">")) {
+			return nil, fmt.Errorf("a numeric range must be a lower bound (> or >=) followed by an upper bound (< or <=)")
+		}
+
+		matcher.conditions = append(matcher.conditions, numericCondition{op: op, value: f})
+	}
+
+	if len(matcher.conditions) == 2 && matcher.conditions[0].value > matcher.conditions[1].value {
+		return nil, fmt.Errorf("numeric range lower bound must not be greater than its upper bound")
+	}
+
+	return matcher, nil
+}
+
+func (n *eventPatternNode) match(objects []interface{}) bool {
+	for k, matchers := range n.leaves {
+		values := eventFieldValues(objects, k)
+
+		if !anyEventPatternMatcher(matchers, values) {
+			return false
+		}
+	}
+
+	for k, child := range n.children {
+		var children []interface{}
+
+		for _, v := range eventFieldValues(objects, k) {
+			if _, ok := v.(map[string]interface{}); ok {
+				children = append(children, v)
+			}
+		}
+
+		if !child.match(children) {
+			return false
+		}
+	}
+
+	if len(n.or) > 0 {
+		for _, alt := range n.or {
+			if alt.match(objects) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return true
+}
+
+// eventFieldValues returns the values of a field across objects, flattening arrays
+// the same way EventBridge does.
+func eventFieldValues(objects []interface{}, key string) []interface{} {
+	var values []interface{}
+
+	var add func(v interface{})
+	add = func(v interface{}) {
+		if l, ok := v.([]interface{}); ok {
+			for _, v := range l {
+				add(v)
+			}
+
+			return
+		}
+
+		values = append(values, v)
+	}
+
+	for _, object := range objects {
+		m, ok := object.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := m[key]; ok {
+			add(v)
+		}
+	}
+
+	return values
+}
+
+func anyEventPatternMatcher(matchers []eventPatternMatcher, values []interface{}) bool {
+	for _, m := range matchers {
+		if m.match(values) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type literalMatcher struct {
+	value interface{}
+}
+
+func (m literalMatcher) match(values []interface{}) bool {
+	for _, v := range values {
+		if m.matchValue(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m literalMatcher) matchValue(v interface{}) bool {
+	switch want := m.value.(type) {
+	case float64:
+		f, ok := eventNumber(v)
+		return ok && f == want
+	default:
+		return v == want
+	}
+}
+
+type stringMatcher struct {
+	value   string
+	compare func(s, value string) bool
+}
+
+func (m stringMatcher) match(values []interface{}) bool {
+	for _, v := range values {
+		if s, ok := v.(string); ok && m.compare(s, m.value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// anythingButMatcher matches values that none of its matchers match. Like EventBridge,
+// it does not match a missing field.
+type anythingButMatcher struct {
+	inner []eventPatternMatcher
+}
+
+func (m anythingButMatcher) match(values []interface{}) bool {
+	for _, v := range values {
+		if !anyEventPatternMatcher(m.inner, []interface{}{v}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type existsMatcher bool
+
+func (m existsMatcher) match(values []interface{}) bool {
+	var leaves int
+
+	for _, v := range values {
+		if _, ok := v.(map[string]interface{}); !ok {
+			leaves++
+		}
+	}
+
+	return (leaves > 0) == bool(m)
+}
+
+type numericCondition struct {
+	op    string
+	value float64
+}
+
+type numericMatcher struct {
+	conditions []numericCondition
+}
+
+func (m numericMatcher) match(values []interface{}) bool {
+	for _, v := range values {
+		f, ok := eventNumber(v)
+
+		if ok && m.matchNumber(f) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m numericMatcher) matchNumber(f float64) bool {
+	for _, c := range m.conditions {
+		var ok bool
+
+		switch c.op {
+		case "=":
+			ok = f == c.value
+		case "<":
+			ok = f < c.value
+		case "<=":
+			ok = f <= c.value
+		case ">":
+			ok = f > c.value
+		case ">=":
+			ok = f >= c.value
+		}
+
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+type cidrMatcher struct {
+	ipNet *net.IPNet
+}
+
+func (m cidrMatcher) match(values []interface{}) bool {
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			if ip := net.ParseIP(s); ip != nil && m.ipNet.Contains(ip) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// wildcardMatch reports whether s matches pattern, in which * matches any sequence of characters
+// and \* matches a literal *.
+func wildcardMatch(s, pattern string) bool {
+	var parts []string
+	var part strings.Builder
+
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case pattern[i] == '\\' && i+1 < len(pattern):
+			i++
+			part.WriteByte(pattern[i])
+		case pattern[i] == '*':
+			parts = append(parts, part.String())
+			part.Reset()
+		default:
+			part.WriteByte(pattern[i])
+		}
+	}
+
+	parts = append(parts, part.String())
+
+	if len(parts) == 1 {
+		return s == parts[0]
+	}
+
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+
+	s = s[len(parts[0]):]
+
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+
+		if i < 0 {
+			return false
+		}
+
+		s = s[i+len(part):]
+	}
+
+	return strings.HasSuffix(s, parts[len(parts)-1])
+}
+
+func eventNumber(v interface{}) (float64, bool) {
+	n, ok := v.(json.Number)
+
+	if !ok {
+		return 0, false
+	}
+
+	f, err := n.Float64()
+
+	if err != nil || math.IsInf(f, 0) {
+		return 0, false
+	}
+
+	return f, true
+}
+
+func eventValueType(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+func joinEventPatternPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
@@ -0,0 +1,88 @@
+package events
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+)
+
+func DataSourcePatternMatch() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePatternMatchRead,
+
+		Schema: map[string]*schema.Schema{
+			"all_match": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"any_match": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"event_pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEventPatternValue(),
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+			"matches": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeBool},
+			},
+		},
+	}
+}
+
+func dataSourcePatternMatchRead(d *schema.ResourceData, meta interface{}) error {
+	eventPattern := d.Get("event_pattern").(string)
+	pattern, err := ParseEventPattern(eventPattern)
+
+	if err != nil {
+		return fmt.Errorf("error parsing event pattern: %w", err)
+	}
+
+	events := d.Get("events").([]interface{})
+	matches := make([]interface{}, len(events))
+	allMatch, anyMatch := true, false
+
+	for i, v := range events {
+		match, err := pattern.Matches(v.(string))
+
+		if err != nil {
+			return fmt.Errorf("error evaluating event %d: %w", i, err)
+		}
+
+		matches[i] = match
+		allMatch = allMatch && match
+		anyMatch = anyMatch || match
+	}
+
+	var id strings.Builder
+	id.WriteString(eventPattern)
+	for _, v := range events {
+		id.WriteString("\n")
+		id.WriteString(v.(string))
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(id.String())))
+	d.Set("all_match", allMatch)
+	d.Set("any_match", anyMatch)
+	if err := d.Set("matches", matches); err != nil {
+		return fmt.Errorf("error setting matches: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package events_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEventsPatternMatchDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_cloudwatch_event_pattern_match.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatternMatchDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_match", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "any_match", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.0", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.1", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.2", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsPatternMatchDataSource_invalidPattern(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPatternMatchDataSourceConfig_invalidPattern,
+				ExpectError: regexp.MustCompile(`unknown content filter "startswith"`),
+			},
+		},
+	})
+}
+
+const testAccPatternMatchDataSourceConfig = `
+data "aws_cloudwatch_event_pattern_match" "test" {
+  event_pattern = jsonencode({
+    source = ["aws.ec2"]
+    detail = {
+      state = [{ anything-but = ["running", "pending"] }]
+      "$or" = [
+        { count = [{ numeric = [">", 0, "<=", 10] }] },
+        { "source-ip" = [{ cidr = "10.0.0.0/8" }] },
+      ]
+    }
+  })
+
+  events = [
+    jsonencode({ source = "aws.ec2", detail = { state = "stopped", count = 5 } }),
+    jsonencode({ source = "aws.ec2", detail = { state = "running", count = 5 } }),
+    jsonencode({ source = "aws.ec2", detail = { state = "stopped", "source-ip" = "10.1.2.3" } }),
+  ]
+}
+`
+
+const testAccPatternMatchDataSourceConfig_invalidPattern = `
+data "aws_cloudwatch_event_pattern_match" "test" {
+  event_pattern = jsonencode({
+    source = [{ startswith = "aws." }]
+  })
+
+  events = [
+    jsonencode({ source = "aws.ec2" }),
+  ]
+}
+`
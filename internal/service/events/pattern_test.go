@@ -0,0 +1,195 @@
+package events
+
+import (
+	"testing"
+)
+
+func TestParseEventPattern(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Pattern  string
+		Expected string
+	}{
+		{
+			Name:    "valid",
+			Pattern: `{"source": ["aws.ec2"], "detail": {"state": [{"anything-but": ["running", "pending"]}], "count": [{"numeric": [">", 0, "<=", 5]}], "ip": [{"cidr": "10.0.0.0/8"}], "$or": [{"a": [1]}, {"b": [{"exists": false}]}]}}`,
+		},
+		{
+			Name:     "invalid JSON",
+			Pattern:  `{"source": `,
+			Expected: "invalid JSON: unexpected EOF",
+		},
+		{
+			Name:     "not an object",
+			Pattern:  `["aws.ec2"]`,
+			Expected: "event pattern must be a non-empty JSON object",
+		},
+		{
+			Name:     "scalar value",
+			Pattern:  `{"source": "aws.ec2"}`,
+			Expected: "source: must be an object or an array, got string",
+		},
+		{
+			Name:     "empty array",
+			Pattern:  `{"detail": {"state": []}}`,
+			Expected: "detail.state: must be a non-empty array",
+		},
+		{
+			Name:     "unknown filter",
+			Pattern:  `{"source": [{"startswith": "aws."}]}`,
+			Expected: `source[0]: unknown content filter "startswith"`,
+		},
+		{
+			Name:     "numeric range order",
+			Pattern:  `{"count": [{"numeric": ["<", 5, ">", 0]}]}`,
+			Expected: "count[0]: a numeric range must be a lower bound (> or >=) followed by an upper bound (< or <=)",
+		},
+		{
+			Name:     "numeric operator",
+			Pattern:  `{"count": [{"numeric": ["!=", 5]}]}`,
+			Expected: `count[0]: unknown numeric operator "!="`,
+		},
+		{
+			Name:     "invalid CIDR",
+			Pattern:  `{"ip": [{"cidr": "10.0.0.0/33"}]}`,
+			Expected: "ip[0]: cidr: invalid CIDR address: 10.0.0.0/33",
+		},
+		{
+			Name:     "exists",
+			Pattern:  `{"ip": [{"exists": "yes"}]}`,
+			Expected: "ip[0]: exists must be true or false",
+		},
+		{
+			Name:     "or with one pattern",
+			Pattern:  `{"$or": [{"a": [1]}]}`,
+			Expected: "$or: must contain at least 2 patterns",
+		},
+		{
+			Name:     "anything-but object",
+			Pattern:  `{"a": [{"anything-but": {"numeric": [">", 1]}}]}`,
+			Expected: `a[0]: unsupported anything-but content filter "numeric"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := ParseEventPattern(testCase.Pattern)
+
+			var got string
+			if err != nil {
+				got = err.Error()
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("expected error %q, got %q", testCase.Expected, got)
+			}
+		})
+	}
+}
+
+func TestEventPatternMatches(t *testing.T) {
+	event := `{
+  "source": "aws.ec2",
+  "detail-type": "EC2 Instance State-change Notification",
+  "account": "123456789012",
+  "region": "us-west-2",
+  "resources": ["arn:aws:ec2:us-west-2:123456789012:instance/i-1234567890abcdef0"],
+  "detail": {
+    "instance-id": "i-1234567890abcdef0",
+    "state": "stopped",
+    "count": 5,
+    "ratio": 0.25,
+    "enabled": true,
+    "owner": null,
+    "source-ip": "10.0.12.34",
+    "tags": [{"key": "env", "value": "prod"}, {"key": "team", "value": "Payments"}],
+    "file": "reports/2022/summary.CSV"
+  }
+}`
+
+	testCases := []struct {
+		Name     string
+		Pattern  string
+		Expected bool
+	}{
+		{"exact", `{"source": ["aws.ec2"]}`, true},
+		{"exact mismatch", `{"source": ["aws.s3"]}`, false},
+		{"any of", `{"source": ["aws.s3", "aws.ec2"]}`, true},
+		{"nested", `{"detail": {"state": ["stopped"]}}`, true},
+		{"array value", `{"resources": ["arn:aws:ec2:us-west-2:123456789012:instance/i-1234567890abcdef0"]}`, true},
+		{"number", `{"detail": {"count": [5.0]}}`, true},
+		{"number is not string", `{"detail": {"count": ["5"]}}`, false},
+		{"boolean", `{"detail": {"enabled": [true]}}`, true},
+		{"null", `{"detail": {"owner": [null]}}`, true},
+		{"prefix", `{"detail-type": [{"prefix": "EC2 Instance"}]}`, true},
+		{"prefix ignore case", `{"detail-type": [{"prefix": {"equals-ignore-case": "ec2 instance"}}]}`, true},
+		{"suffix", `{"detail": {"file": [{"suffix": ".csv"}]}}`, false},
+		{"suffix ignore case", `{"detail": {"file": [{"suffix": {"equals-ignore-case": ".csv"}}]}}`, true},
+		{"equals ignore case", `{"detail": {"state": [{"equals-ignore-case": "STOPPED"}]}}`, true},
+		{"wildcard", `{"detail": {"file": [{"wildcard": "reports/*/summary.*"}]}}`, true},
+		{"wildcard mismatch", `{"detail": {"file": [{"wildcard": "reports/*/detail.*"}]}}`, false},
+		{"anything-but", `{"detail": {"state": [{"anything-but": ["running", "pending"]}]}}`, true},
+		{"anything-but mismatch", `{"detail": {"state": [{"anything-but": "stopped"}]}}`, false},
+		{"anything-but prefix", `{"source": [{"anything-but": {"prefix": "aws."}}]}`, false},
+		{"anything-but missing field", `{"detail": {"missing": [{"anything-but": "x"}]}}`, false},
+		{"numeric range", `{"detail": {"count": [{"numeric": [">", 0, "<=", 5]}]}}`, true},
+		{"numeric range mismatch", `{"detail": {"ratio": [{"numeric": [">=", 1]}]}}`, false},
+		{"numeric equals", `{"detail": {"count": [{"numeric": ["=", 5]}]}}`, true},
+		{"exists", `{"detail": {"instance-id": [{"exists": true}]}}`, true},
+		{"exists object", `{"detail": {"tags": [{"exists": true}]}}`, false},
+		{"not exists", `{"detail": {"missing": [{"exists": false}]}}`, true},
+		{"not exists mismatch", `{"detail": {"state": [{"exists": false}]}}`, false},
+		{"cidr", `{"detail": {"source-ip": [{"cidr": "10.0.0.0/16"}]}}`, true},
+		{"cidr mismatch", `{"detail": {"source-ip": [{"cidr": "192.168.0.0/16"}]}}`, false},
+		{"array of objects", `{"detail": {"tags": {"value": ["prod"]}}}`, true},
+		{"array of objects mismatch", `{"detail": {"tags": {"key": ["owner"]}}}`, false},
+		{"missing object", `{"missing": {"key": ["x"]}}`, false},
+		{"all fields", `{"source": ["aws.ec2"], "detail": {"state": ["running"]}}`, false},
+		{"or", `{"source": ["aws.ec2"], "$or": [{"detail": {"state": ["running"]}}, {"detail": {"count": [{"numeric": [">", 4]}]}}]}`, true},
+		{"or mismatch", `{"$or": [{"region": ["eu-west-1"]}, {"account": ["111111111111"]}]}`, false},
+		{"nested or", `{"detail": {"$or": [{"state": ["running"]}, {"enabled": [true]}]}}`, true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			pattern, err := ParseEventPattern(testCase.Pattern)
+
+			if err != nil {
+				t.Fatalf("unexpected error parsing pattern: %s", err)
+			}
+
+			got, err := pattern.Matches(event)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("expected %t, got %t", testCase.Expected, got)
+			}
+		})
+	}
+}
+
+func TestWildcardMatch(t *testing.T) {
+	testCases := []struct {
+		Value    string
+		Pattern  string
+		Expected bool
+	}{
+		{"abc", "abc", true},
+		{"abc", "a*", true},
+		{"abc", "*c", true},
+		{"abc", "a*b*c", true},
+		{"ab", "ab*b", false},
+		{"a*c", `a\*c`, true},
+		{"abc", `a\*c`, false},
+		{"", "*", true},
+	}
+
+	for _, tc := range testCases {
+		if got := wildcardMatch(tc.Value, tc.Pattern); got != tc.Expected {
+			t.Errorf("wildcardMatch(%q, %q): expected %t, got %t", tc.Value, tc.Pattern, tc.Expected, got)
+		}
+	}
+}
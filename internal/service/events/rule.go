@@ -409,6 +409,10 @@ func validateEventPatternValue() schema.SchemaValidateFunc {
 		if len(json) > maxJsonLength {
 			errors = append(errors, fmt.Errorf("%q cannot be longer than %d characters: %q", k, maxJsonLength, json))
 		}
+
+		if _, err := ParseEventPattern(json); err != nil {
+			errors = append(errors, fmt.Errorf("%q is not a valid event pattern: %w", k, err))
+		}
 		return
 	}
 }
@@ -5,8 +5,11 @@ import (
 	"log"
 	"math"
 	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
@@ -16,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -77,7 +81,7 @@ func ResourceTarget() *schema.Resource {
 					validation.StringIsJSON,
 					validation.StringLenBetween(0, 8192),
 				),
-				ConflictsWith: []string{"input_path", "input_transformer"},
+				ConflictsWith: []string{"input_path", "input_transformer", "ecs_target.0.overrides"},
 				// We could be normalizing the JSON here,
 				// but for built-in targets input may not be JSON
 			},
@@ -86,7 +90,7 @@ func ResourceTarget() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ValidateFunc:  validation.StringLenBetween(0, 256),
-				ConflictsWith: []string{"input", "input_transformer"},
+				ConflictsWith: []string{"input", "input_transformer", "ecs_target.0.overrides"},
 			},
 
 			"role_arn": {
@@ -162,6 +166,7 @@ func ResourceTarget() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"capacity_provider_strategy": tfecs.CapacityProviderStrategySchema(),
 						"enable_ecs_managed_tags": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -206,6 +211,8 @@ func ResourceTarget() *schema.Resource {
 								},
 							},
 						},
+						// Task overrides are passed to ECS as the target's input.
+						"overrides": tfecs.TaskOverrideSchema(),
 						"placement_constraint": {
 							Type:     schema.TypeSet,
 							Optional: true,
@@ -224,6 +231,7 @@ func ResourceTarget() *schema.Resource {
 								},
 							},
 						},
+						"placement_strategy": tfecs.PlacementStrategySchema(),
 						"platform_version": {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -333,6 +341,35 @@ func ResourceTarget() *schema.Resource {
 				},
 			},
 
+			"sagemaker_pipeline_target": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pipeline_parameter_list": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 200,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"value": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(0, 1024),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"sqs_target": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -351,7 +388,7 @@ func ResourceTarget() *schema.Resource {
 				Type:          schema.TypeList,
 				Optional:      true,
 				MaxItems:      1,
-				ConflictsWith: []string{"input", "input_path"},
+				ConflictsWith: []string{"input", "input_path", "ecs_target.0.overrides"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"input_paths": {
@@ -427,7 +464,10 @@ func resourceTargetCreate(d *schema.ResourceData, meta interface{}) error {
 		busName = v.(string)
 	}
 
-	input := buildPutTargetInputStruct(d)
+	input, err := buildPutTargetInputStruct(d)
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] Creating EventBridge Target: %s", input)
 	out, err := conn.PutTargets(input)
@@ -467,7 +507,18 @@ func resourceTargetRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("arn", t.Arn)
 	d.Set("target_id", t.Id)
-	d.Set("input", t.Input)
+
+	// When ECS task overrides are configured, they are the target's input.
+	var ecsOverrides []interface{}
+	if v, ok := d.Get("ecs_target.0.overrides").([]interface{}); ok && len(v) > 0 {
+		ecsOverrides, err = flattenTargetECSOverrides(t.Input)
+		if err != nil {
+			return err
+		}
+	} else {
+		d.Set("input", t.Input)
+	}
+
 	d.Set("input_path", t.InputPath)
 	d.Set("role_arn", t.RoleArn)
 	d.Set("event_bus_name", busName)
@@ -493,7 +544,7 @@ func resourceTargetRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if t.EcsParameters != nil {
-		if err := d.Set("ecs_target", flattenTargetECSParameters(t.EcsParameters)); err != nil {
+		if err := d.Set("ecs_target", flattenTargetECSParameters(t.EcsParameters, ecsOverrides)); err != nil {
 			return fmt.Errorf("Error setting ecs_target error: %w", err)
 		}
 	}
@@ -510,6 +561,14 @@ func resourceTargetRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if t.SageMakerPipelineParameters != nil {
+		if err := d.Set("sagemaker_pipeline_target", flattenTargetSageMakerPipelineParameters(t.SageMakerPipelineParameters)); err != nil {
+			return fmt.Errorf("error setting sagemaker_pipeline_target: %w", err)
+		}
+	} else {
+		d.Set("sagemaker_pipeline_target", nil)
+	}
+
 	if t.SqsParameters != nil {
 		if err := d.Set("sqs_target", flattenTargetSQSParameters(t.SqsParameters)); err != nil {
 			return fmt.Errorf("Error setting sqs_target error: %w", err)
@@ -540,10 +599,13 @@ func resourceTargetRead(d *schema.ResourceData, meta interface{}) error {
 func resourceTargetUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EventsConn
 
-	input := buildPutTargetInputStruct(d)
+	input, err := buildPutTargetInputStruct(d)
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] Updating EventBridge Target: %s", input)
-	_, err := conn.PutTargets(input)
+	_, err = conn.PutTargets(input)
 	if err != nil {
 		return fmt.Errorf("error updating EventBridge Target (%s): %w", d.Id(), err)
 	}
@@ -579,7 +641,7 @@ func resourceTargetDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func buildPutTargetInputStruct(d *schema.ResourceData) *eventbridge.PutTargetsInput {
+func buildPutTargetInputStruct(d *schema.ResourceData) (*eventbridge.PutTargetsInput, error) {
 	e := &eventbridge.Target{
 		Arn: aws.String(d.Get("arn").(string)),
 		Id:  aws.String(d.Get("target_id").(string)),
@@ -601,7 +663,19 @@ func buildPutTargetInputStruct(d *schema.ResourceData) *eventbridge.PutTargetsIn
 	}
 
 	if v, ok := d.GetOk("ecs_target"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		e.EcsParameters = expandTargetECSParameters(v.([]interface{}))
+		ecsParameters, err := expandTargetECSParameters(v.([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		e.EcsParameters = ecsParameters
+
+		if v, ok := v.([]interface{})[0].(map[string]interface{})["overrides"].([]interface{}); ok && len(v) > 0 {
+			input, err := expandTargetECSOverrides(v)
+			if err != nil {
+				return nil, err
+			}
+			e.Input = aws.String(input)
+		}
 	}
 
 	if v, ok := d.GetOk("redshift_target"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -620,6 +694,10 @@ func buildPutTargetInputStruct(d *schema.ResourceData) *eventbridge.PutTargetsIn
 		e.KinesisParameters = expandTargetKinesisParameters(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("sagemaker_pipeline_target"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		e.SageMakerPipelineParameters = expandTargetSageMakerPipelineParameters(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("sqs_target"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		e.SqsParameters = expandTargetSQSParameters(v.([]interface{}))
 	}
@@ -644,7 +722,7 @@ func buildPutTargetInputStruct(d *schema.ResourceData) *eventbridge.PutTargetsIn
 		input.EventBusName = aws.String(v.(string))
 	}
 
-	return &input
+	return &input, nil
 }
 
 func expandTargetRunParameters(config []interface{}) *eventbridge.RunCommandParameters {
@@ -693,7 +771,7 @@ func expandTargetRedshiftParameters(config []interface{}) *eventbridge.RedshiftD
 	return redshiftParameters
 }
 
-func expandTargetECSParameters(config []interface{}) *eventbridge.EcsParameters {
+func expandTargetECSParameters(config []interface{}) (*eventbridge.EcsParameters, error) {
 	ecsParameters := &eventbridge.EcsParameters{}
 	for _, c := range config {
 		param := c.(map[string]interface{})
@@ -719,6 +797,18 @@ func expandTargetECSParameters(config []interface{}) *eventbridge.EcsParameters
 			ecsParameters.PlacementConstraints = expandTargetPlacementConstraints(v.List())
 		}
 
+		if v, ok := param["capacity_provider_strategy"].(*schema.Set); ok && v.Len() > 0 {
+			ecsParameters.CapacityProviderStrategy = expandTargetCapacityProviderStrategy(v)
+		}
+
+		if v, ok := param["placement_strategy"].([]interface{}); ok && len(v) > 0 {
+			placementStrategy, err := expandTargetPlacementStrategy(v)
+			if err != nil {
+				return nil, err
+			}
+			ecsParameters.PlacementStrategy = placementStrategy
+		}
+
 		if v, ok := param["propagate_tags"].(string); ok {
 			ecsParameters.PropagateTags = aws.String(v)
 		}
@@ -733,7 +823,7 @@ func expandTargetECSParameters(config []interface{}) *eventbridge.EcsParameters
 		ecsParameters.TaskDefinitionArn = aws.String(param["task_definition_arn"].(string))
 	}
 
-	return ecsParameters
+	return ecsParameters, nil
 }
 
 func expandRetryPolicyParameters(rp []interface{}) *eventbridge.RetryPolicy {
@@ -889,7 +979,7 @@ func flattenTargetRunParameters(runCommand *eventbridge.RunCommandParameters) []
 	return result
 }
 
-func flattenTargetECSParameters(ecsParameters *eventbridge.EcsParameters) []map[string]interface{} {
+func flattenTargetECSParameters(ecsParameters *eventbridge.EcsParameters, overrides []interface{}) []map[string]interface{} {
 	config := make(map[string]interface{})
 	if ecsParameters.Group != nil {
 		config["group"] = aws.StringValue(ecsParameters.Group)
@@ -912,6 +1002,10 @@ func flattenTargetECSParameters(ecsParameters *eventbridge.EcsParameters) []map[
 		config["placement_constraint"] = flattenTargetPlacementConstraints(ecsParameters.PlacementConstraints)
 	}
 
+	config["capacity_provider_strategy"] = flattenTargetCapacityProviderStrategy(ecsParameters.CapacityProviderStrategy)
+	config["overrides"] = overrides
+	config["placement_strategy"] = flattenTargetPlacementStrategy(ecsParameters.PlacementStrategy)
+
 	config["tags"] = KeyValueTags(ecsParameters.Tags).IgnoreAWS().Map()
 	config["enable_execute_command"] = aws.BoolValue(ecsParameters.EnableExecuteCommand)
 	config["enable_ecs_managed_tags"] = aws.BoolValue(ecsParameters.EnableECSManagedTags)
@@ -1108,3 +1202,149 @@ func allDiagFunc(validators ...schema.SchemaValidateDiagFunc) schema.SchemaValid
 		return diags
 	}
 }
+
+// The following helpers convert between EventBridge and ECS API types so that ECS scheduling
+// options are expanded and flattened by the same code as aws_ecs_service.
+
+func expandTargetCapacityProviderStrategy(tfSet *schema.Set) []*eventbridge.CapacityProviderStrategyItem {
+	var apiObjects []*eventbridge.CapacityProviderStrategyItem
+
+	for _, v := range tfecs.ExpandCapacityProviderStrategy(tfSet) {
+		apiObjects = append(apiObjects, &eventbridge.CapacityProviderStrategyItem{
+			Base:             v.Base,
+			CapacityProvider: v.CapacityProvider,
+			Weight:           v.Weight,
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenTargetCapacityProviderStrategy(apiObjects []*eventbridge.CapacityProviderStrategyItem) []map[string]interface{} {
+	if apiObjects == nil {
+		return nil
+	}
+
+	var ecsObjects []*ecs.CapacityProviderStrategyItem
+
+	for _, v := range apiObjects {
+		if v == nil {
+			continue
+		}
+
+		ecsObjects = append(ecsObjects, &ecs.CapacityProviderStrategyItem{
+			Base:             v.Base,
+			CapacityProvider: v.CapacityProvider,
+			Weight:           v.Weight,
+		})
+	}
+
+	return tfecs.FlattenCapacityProviderStrategy(ecsObjects)
+}
+
+func expandTargetPlacementStrategy(tfList []interface{}) ([]*eventbridge.PlacementStrategy, error) {
+	ecsObjects, err := tfecs.ExpandPlacementStrategy(tfList)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var apiObjects []*eventbridge.PlacementStrategy
+
+	for _, v := range ecsObjects {
+		apiObjects = append(apiObjects, &eventbridge.PlacementStrategy{
+			Field: v.Field,
+			Type:  v.Type,
+		})
+	}
+
+	return apiObjects, nil
+}
+
+func flattenTargetPlacementStrategy(apiObjects []*eventbridge.PlacementStrategy) []interface{} {
+	var ecsObjects []*ecs.PlacementStrategy
+
+	for _, v := range apiObjects {
+		if v == nil {
+			continue
+		}
+
+		ecsObjects = append(ecsObjects, &ecs.PlacementStrategy{
+			Field: v.Field,
+			Type:  v.Type,
+		})
+	}
+
+	return tfecs.FlattenPlacementStrategy(ecsObjects)
+}
+
+// expandTargetECSOverrides returns ECS task overrides as target input, in the format of the ECS RunTask API.
+func expandTargetECSOverrides(tfList []interface{}) (string, error) {
+	apiObject := tfecs.ExpandTaskOverride(tfList)
+
+	if apiObject == nil {
+		apiObject = &ecs.TaskOverride{}
+	}
+
+	b, err := jsonutil.BuildJSON(apiObject)
+
+	if err != nil {
+		return "", fmt.Errorf("error encoding ECS task overrides: %w", err)
+	}
+
+	return string(b), nil
+}
+
+func flattenTargetECSOverrides(input *string) ([]interface{}, error) {
+	if aws.StringValue(input) == "" {
+		return nil, nil
+	}
+
+	apiObject := &ecs.TaskOverride{}
+
+	if err := jsonutil.UnmarshalJSON(apiObject, strings.NewReader(aws.StringValue(input))); err != nil {
+		return nil, fmt.Errorf("error decoding ECS task overrides: %w", err)
+	}
+
+	return tfecs.FlattenTaskOverride(apiObject), nil
+}
+
+func expandTargetSageMakerPipelineParameters(config []interface{}) *eventbridge.SageMakerPipelineParameters {
+	apiObject := &eventbridge.SageMakerPipelineParameters{}
+
+	tfMap, ok := config[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["pipeline_parameter_list"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+
+			apiObject.PipelineParameterList = append(apiObject.PipelineParameterList, &eventbridge.SageMakerPipelineParameter{
+				Name:  aws.String(tfMap["name"].(string)),
+				Value: aws.String(tfMap["value"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenTargetSageMakerPipelineParameters(apiObject *eventbridge.SageMakerPipelineParameters) []map[string]interface{} {
+	var parameters []interface{}
+
+	for _, v := range apiObject.PipelineParameterList {
+		if v == nil {
+			continue
+		}
+
+		parameters = append(parameters, map[string]interface{}{
+			"name":  aws.StringValue(v.Name),
+			"value": aws.StringValue(v.Value),
+		})
+	}
+
+	return []map[string]interface{}{{"pipeline_parameter_list": parameters}}
+}
@@ -0,0 +1,106 @@
+package events
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+)
+
+func TestExpandFlattenTargetECSOverrides(t *testing.T) {
+	tfList := []interface{}{
+		map[string]interface{}{
+			"container_override": []interface{}{
+				map[string]interface{}{
+					"command": []interface{}{"run.sh"},
+					"name":    "app",
+				},
+			},
+			"task_role_arn": "arn:aws:iam::123456789012:role/task",
+		},
+	}
+
+	input, err := expandTargetECSOverrides(tfList)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Overrides are passed to ECS in the format of the RunTask API.
+	expected := `{"containerOverrides":[{"command":["run.sh"],"name":"app"}],"taskRoleArn":"arn:aws:iam::123456789012:role/task"}`
+
+	if input != expected {
+		t.Errorf("expected %s, got %s", expected, input)
+	}
+
+	got, err := flattenTargetECSOverrides(aws.String(input))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tfMap := got[0].(map[string]interface{})
+
+	if v := tfMap["task_role_arn"]; v != "arn:aws:iam::123456789012:role/task" {
+		t.Errorf("expected task_role_arn to round trip, got %v", v)
+	}
+
+	if v := tfMap["container_override"].([]interface{})[0].(map[string]interface{})["command"]; !reflect.DeepEqual(v, []string{"run.sh"}) {
+		t.Errorf("expected command to round trip, got %v", v)
+	}
+
+	if _, err := flattenTargetECSOverrides(aws.String("not JSON")); err == nil {
+		t.Error("expected an error decoding invalid input")
+	}
+}
+
+func TestExpandFlattenTargetPlacementStrategy(t *testing.T) {
+	tfList := []interface{}{
+		map[string]interface{}{
+			"field": "attribute:ecs.availability-zone",
+			"type":  eventbridge.PlacementStrategyTypeSpread,
+		},
+	}
+
+	apiObjects, err := expandTargetPlacementStrategy(tfList)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []*eventbridge.PlacementStrategy{
+		{
+			Field: aws.String("attribute:ecs.availability-zone"),
+			Type:  aws.String(eventbridge.PlacementStrategyTypeSpread),
+		},
+	}
+
+	if !reflect.DeepEqual(apiObjects, expected) {
+		t.Errorf("expected %v, got %v", expected, apiObjects)
+	}
+
+	if got := flattenTargetPlacementStrategy(apiObjects); !reflect.DeepEqual(got, tfList) {
+		t.Errorf("expected %v, got %v", tfList, got)
+	}
+}
+
+func TestFlattenTargetCapacityProviderStrategy(t *testing.T) {
+	apiObjects := []*eventbridge.CapacityProviderStrategyItem{
+		{
+			CapacityProvider: aws.String("FARGATE_SPOT"),
+			Weight:           aws.Int64(3),
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"capacity_provider": "FARGATE_SPOT",
+			"weight":            int64(3),
+		},
+	}
+
+	if got := flattenTargetCapacityProviderStrategy(apiObjects); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
@@ -674,6 +674,149 @@ func TestAccEventsTarget_ecsFull(t *testing.T) {
 	})
 }
 
+func TestAccEventsTarget_ecsCapacityProviderStrategy(t *testing.T) {
+	resourceName := "aws_cloudwatch_event_target.test"
+	var v eventbridge.Target
+	rName := sdkacctest.RandomWithPrefix("tf_ecs_target")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetECSCapacityProviderStrategyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.launch_type", ""),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.capacity_provider_strategy.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ecs_target.0.capacity_provider_strategy.*", map[string]string{
+						"base":              "1",
+						"capacity_provider": "FARGATE",
+						"weight":            "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ecs_target.0.capacity_provider_strategy.*", map[string]string{
+						"capacity_provider": "FARGATE_SPOT",
+						"weight":            "3",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTargetImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEventsTarget_ecsPlacementStrategy(t *testing.T) {
+	resourceName := "aws_cloudwatch_event_target.test"
+	var v eventbridge.Target
+	rName := sdkacctest.RandomWithPrefix("tf_ecs_target")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetECSPlacementStrategyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.placement_strategy.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.placement_strategy.0.type", "spread"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.placement_strategy.0.field", "attribute:ecs.availability-zone"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.placement_strategy.1.type", "binpack"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.placement_strategy.1.field", "memory"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTargetImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEventsTarget_ecsOverrides(t *testing.T) {
+	resourceName := "aws_cloudwatch_event_target.test"
+	var v eventbridge.Target
+	rName := sdkacctest.RandomWithPrefix("tf_ecs_target")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetECSOverridesConfig(rName, "hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "input", ""),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.overrides.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.overrides.0.container_override.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.overrides.0.container_override.0.name", "first"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.overrides.0.container_override.0.command.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.overrides.0.container_override.0.command.1", "hello"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ecs_target.0.overrides.0.container_override.0.environment.*", map[string]string{
+						"name":  "STAGE",
+						"value": "test",
+					}),
+				),
+			},
+			{
+				Config: testAccTargetECSOverridesConfig(rName, "goodbye"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.overrides.0.container_override.0.command.1", "goodbye"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsTarget_sageMakerPipeline(t *testing.T) {
+	resourceName := "aws_cloudwatch_event_target.test"
+	var v eventbridge.Target
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetSageMakerPipelineConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "sagemaker_pipeline_target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sagemaker_pipeline_target.0.pipeline_parameter_list.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "sagemaker_pipeline_target.0.pipeline_parameter_list.*", map[string]string{
+						"name":  "key",
+						"value": "value",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTargetImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccEventsTarget_batch(t *testing.T) {
 	resourceName := "aws_cloudwatch_event_target.test"
 	batchJobDefinitionResourceName := "aws_batch_job_definition.test"
@@ -1668,6 +1811,139 @@ resource "aws_cloudwatch_event_target" "test" {
 `
 }
 
+func testAccTargetECSCapacityProviderStrategyConfig(rName string) string {
+	return testAccTargetECSBaseConfig(rName) + `
+resource "aws_ecs_cluster_capacity_providers" "test" {
+  cluster_name       = aws_ecs_cluster.test.name
+  capacity_providers = ["FARGATE", "FARGATE_SPOT"]
+}
+
+resource "aws_cloudwatch_event_target" "test" {
+  arn      = aws_ecs_cluster.test.arn
+  rule     = aws_cloudwatch_event_rule.test.id
+  role_arn = aws_iam_role.test.arn
+
+  ecs_target {
+    task_definition_arn = aws_ecs_task_definition.task.arn
+
+    capacity_provider_strategy {
+      base              = 1
+      capacity_provider = "FARGATE"
+      weight            = 1
+    }
+
+    capacity_provider_strategy {
+      capacity_provider = "FARGATE_SPOT"
+      weight            = 3
+    }
+
+    network_configuration {
+      subnets = [aws_subnet.subnet.id]
+    }
+  }
+
+  depends_on = [aws_ecs_cluster_capacity_providers.test]
+}
+`
+}
+
+func testAccTargetECSPlacementStrategyConfig(rName string) string {
+	return testAccTargetECSBaseConfig(rName) + `
+resource "aws_cloudwatch_event_target" "test" {
+  arn      = aws_ecs_cluster.test.id
+  rule     = aws_cloudwatch_event_rule.test.id
+  role_arn = aws_iam_role.test.arn
+
+  ecs_target {
+    task_definition_arn = aws_ecs_task_definition.task.arn
+    launch_type         = "EC2"
+
+    placement_strategy {
+      type  = "spread"
+      field = "attribute:ecs.availability-zone"
+    }
+
+    placement_strategy {
+      type  = "binpack"
+      field = "memory"
+    }
+  }
+}
+`
+}
+
+func testAccTargetECSOverridesConfig(rName, argument string) string {
+	return testAccTargetECSBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_cloudwatch_event_target" "test" {
+  arn      = aws_ecs_cluster.test.id
+  rule     = aws_cloudwatch_event_rule.test.id
+  role_arn = aws_iam_role.test.arn
+
+  ecs_target {
+    task_definition_arn = aws_ecs_task_definition.task.arn
+    launch_type         = "FARGATE"
+
+    network_configuration {
+      subnets = [aws_subnet.subnet.id]
+    }
+
+    overrides {
+      container_override {
+        name    = "first"
+        command = ["echo", %[1]q]
+
+        environment {
+          name  = "STAGE"
+          value = "test"
+        }
+      }
+    }
+  }
+}
+`, argument)
+}
+
+func testAccTargetSageMakerPipelineConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_cloudwatch_event_rule" "test" {
+  name                = %[1]q
+  schedule_expression = "rate(1 day)"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "events.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+
+resource "aws_cloudwatch_event_target" "test" {
+  arn      = "arn:${data.aws_partition.current.partition}:sagemaker:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:pipeline/%[1]s"
+  rule     = aws_cloudwatch_event_rule.test.id
+  role_arn = aws_iam_role.test.arn
+
+  sagemaker_pipeline_target {
+    pipeline_parameter_list {
+      name  = "key"
+      value = "value"
+    }
+  }
+}
+`, rName)
+}
+
 func testAccTargetBatchConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_rule" "test" {
@@ -28,10 +28,49 @@ func DataSourcePolicyDocument() *schema.Resource {
 		Read: dataSourcePolicyDocumentRead,
 
 		Schema: map[string]*schema.Schema{
+			"fail_on_lint_findings": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"json": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"lint": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"lint_findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"check": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"statement_index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"minimize_statements": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"override_json": {
 				Type:       schema.TypeString,
 				Optional:   true,
@@ -269,6 +308,19 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 		mergedDoc.Merge(overrideDoc)
 	}
 
+	if d.Get("minimize_statements").(bool) {
+		mergedDoc.Minimize()
+	}
+
+	var findings []IAMPolicyFinding
+	if d.Get("lint").(bool) || d.Get("fail_on_lint_findings").(bool) {
+		var err error
+		findings, err = mergedDoc.Lint()
+		if err != nil {
+			return fmt.Errorf("error linting policy document: %w", err)
+		}
+	}
+
 	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
 	if err != nil {
 		// should never happen if the above code is correct
@@ -277,11 +329,37 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 	jsonString := string(jsonDoc)
 
 	d.Set("json", jsonString)
+	if err := d.Set("lint_findings", flattenPolicyFindings(findings)); err != nil {
+		return fmt.Errorf("error setting lint_findings: %w", err)
+	}
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
+	if d.Get("fail_on_lint_findings").(bool) && len(findings) > 0 {
+		var msgs []string
+		for _, finding := range findings {
+			msgs = append(msgs, finding.String())
+		}
+		return fmt.Errorf("policy document has %d lint finding(s):\n\t%s", len(findings), strings.Join(msgs, "\n\t"))
+	}
+
 	return nil
 }
 
+func flattenPolicyFindings(findings []IAMPolicyFinding) []interface{} {
+	var tfList []interface{}
+
+	for _, finding := range findings {
+		tfList = append(tfList, map[string]interface{}{
+			"check":           finding.Check,
+			"message":         finding.Message,
+			"sid":             finding.Sid,
+			"statement_index": finding.StatementIndex,
+		})
+	}
+
+	return tfList
+}
+
 func dataSourcePolicyDocumentReplaceVarsInList(in interface{}, version string) (interface{}, error) {
 	switch v := in.(type) {
 	case string:
@@ -223,6 +223,50 @@ func TestAccIAMPolicyDocumentDataSource_duplicateSid(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_lint(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentLintConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "lint_findings.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "lint_findings.0.check", "condition_operator"),
+					resource.TestCheckResourceAttr(dataSourceName, "lint_findings.0.statement_index", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "lint_findings.1.check", "broad_permissions"),
+					resource.TestCheckResourceAttr(dataSourceName, "lint_findings.1.sid", "Broad"),
+					resource.TestCheckResourceAttr(dataSourceName, "lint_findings.2.check", "mergeable_statement"),
+				),
+			},
+			{
+				Config:      testAccPolicyDocumentLintConfig(true),
+				ExpectError: regexp.MustCompile(`policy document has 3 lint finding\(s\)`),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_minimizeStatements(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentMinimizeStatementsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test", "json", testAccPolicyDocumentMinimizeStatementsExpectedJSON),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test", "lint_findings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/10777
 func TestAccIAMPolicyDocumentDataSource_StatementPrincipalIdentifiers_stringAndSlice(t *testing.T) {
 	dataSourceName := "data.aws_iam_policy_document.test"
@@ -1320,3 +1364,72 @@ func testAccPolicyDocumentExpectedJSONStatementPrincipalIdentifiersMultiplePrinc
   ]
 }`, acctest.Partition())
 }
+
+func testAccPolicyDocumentLintConfig(failOnFindings bool) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  lint                  = true
+  fail_on_lint_findings = %[1]t
+
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["arn:aws:s3:::example/*"]
+
+    condition {
+      test     = "StringEqual"
+      variable = "aws:PrincipalTag/team"
+      values   = ["example"]
+    }
+  }
+
+  statement {
+    sid       = "Broad"
+    actions   = ["*"]
+    resources = ["*"]
+  }
+
+  statement {
+    actions   = ["sqs:SendMessage"]
+    resources = ["arn:aws:sqs:us-west-2:123456789012:example"]
+  }
+
+  statement {
+    actions   = ["sqs:ReceiveMessage"]
+    resources = ["arn:aws:sqs:us-west-2:123456789012:example"]
+  }
+}
+`, failOnFindings)
+}
+
+var testAccPolicyDocumentMinimizeStatementsConfig = `
+data "aws_iam_policy_document" "test" {
+  lint                = true
+  minimize_statements = true
+
+  statement {
+    actions   = ["sqs:SendMessage"]
+    resources = ["arn:aws:sqs:us-west-2:123456789012:example"]
+  }
+
+  statement {
+    actions   = ["sqs:ReceiveMessage", "sqs:DeleteMessage"]
+    resources = ["arn:aws:sqs:us-west-2:123456789012:example"]
+  }
+}
+`
+
+var testAccPolicyDocumentMinimizeStatementsExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": [
+        "sqs:SendMessage",
+        "sqs:ReceiveMessage",
+        "sqs:DeleteMessage"
+      ],
+      "Resource": "arn:aws:sqs:us-west-2:123456789012:example"
+    }
+  ]
+}`
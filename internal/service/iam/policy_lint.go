@@ -0,0 +1,374 @@
+package iam
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	policyLintCheckBroadPermissions   = "broad_permissions"
+	policyLintCheckConditionOperator  = "condition_operator"
+	policyLintCheckDuplicateSid       = "duplicate_sid"
+	policyLintCheckMalformedARN       = "malformed_arn"
+	policyLintCheckMergeableStatement = "mergeable_statement"
+	policyLintCheckSize               = "size"
+)
+
+// policyDocumentMaxSize is the maximum size of a managed policy document in characters,
+// not counting white space.
+const policyDocumentMaxSize = 6144
+
+// policyVariableRegexp matches policy variables such as ${aws:username}.
+var policyVariableRegexp = regexp.MustCompile(`\$\{[^}]*\}`)
+
+// policyConditionOperators are the IAM condition operators, without the ForAllValues:/ForAnyValue:
+// set operator prefixes and the IfExists suffix.
+var policyConditionOperators = map[string]struct{}{
+	"ArnEquals":                 {},
+	"ArnLike":                   {},
+	"ArnNotEquals":              {},
+	"ArnNotLike":                {},
+	"BinaryEquals":              {},
+	"Bool":                      {},
+	"DateEquals":                {},
+	"DateGreaterThan":           {},
+	"DateGreaterThanEquals":     {},
+	"DateLessThan":              {},
+	"DateLessThanEquals":        {},
+	"DateNotEquals":             {},
+	"IpAddress":                 {},
+	"NotIpAddress":              {},
+	"Null":                      {},
+	"NumericEquals":             {},
+	"NumericGreaterThan":        {},
+	"NumericGreaterThanEquals":  {},
+	"NumericLessThan":           {},
+	"NumericLessThanEquals":     {},
+	"NumericNotEquals":          {},
+	"StringEquals":              {},
+	"StringEqualsIgnoreCase":    {},
+	"StringLike":                {},
+	"StringNotEquals":           {},
+	"StringNotEqualsIgnoreCase": {},
+	"StringNotLike":             {},
+}
+
+// IAMPolicyFinding is a problem found in a policy document by Lint.
+type IAMPolicyFinding struct {
+	Check          string
+	Message        string
+	Sid            string
+	StatementIndex int
+}
+
+func (f IAMPolicyFinding) String() string {
+	if f.Sid != "" {
+		return fmt.Sprintf("statement %d (%s): %s: %s", f.StatementIndex, f.Sid, f.Check, f.Message)
+	}
+
+	return fmt.Sprintf("statement %d: %s: %s", f.StatementIndex, f.Check, f.Message)
+}
+
+// Lint checks the policy document for common mistakes without calling AWS.
+// Document-level findings have a StatementIndex of -1.
+func (s *IAMPolicyDoc) Lint() ([]IAMPolicyFinding, error) {
+	var findings []IAMPolicyFinding
+
+	sids := make(map[string]int)
+
+	for i, stmt := range s.Statements {
+		finding := func(check, format string, a ...interface{}) {
+			findings = append(findings, IAMPolicyFinding{
+				Check:          check,
+				Message:        fmt.Sprintf(format, a...),
+				Sid:            stmt.Sid,
+				StatementIndex: i,
+			})
+		}
+
+		if stmt.Sid != "" {
+			if j, ok := sids[stmt.Sid]; ok {
+				finding(policyLintCheckDuplicateSid, "Sid is also used by statement %d", j)
+			} else {
+				sids[stmt.Sid] = i
+			}
+		}
+
+		for _, c := range stmt.Conditions {
+			if !validPolicyConditionOperator(c.Test) {
+				finding(policyLintCheckConditionOperator, "unknown condition operator %q", c.Test)
+			}
+		}
+
+		for _, v := range append(iamPolicyStringList(stmt.Resources), iamPolicyStringList(stmt.NotResources)...) {
+			if err := policyResourceARNError(v); err != nil {
+				finding(policyLintCheckMalformedARN, "%s", err)
+			}
+		}
+
+		if action := policyBroadAction(iamPolicyStringList(stmt.Actions)); stmt.Effect != "Deny" && action != "" && policyListContainsWildcard(iamPolicyStringList(stmt.Resources)) {
+			finding(policyLintCheckBroadPermissions, "allows %q on all resources", action)
+		}
+	}
+
+	for _, group := range s.mergeableStatements() {
+		findings = append(findings, IAMPolicyFinding{
+			Check:          policyLintCheckMergeableStatement,
+			Message:        fmt.Sprintf("differs only in actions from statement(s) %s", intsString(group[1:])),
+			Sid:            s.Statements[group[0]].Sid,
+			StatementIndex: group[0],
+		})
+	}
+
+	size, err := s.compactSize()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if size > policyDocumentMaxSize {
+		findings = append(findings, IAMPolicyFinding{
+			Check:          policyLintCheckSize,
+			Message:        fmt.Sprintf("document is %d characters without white space, more than the %d allowed for managed policies", size, policyDocumentMaxSize),
+			StatementIndex: -1,
+		})
+	}
+
+	return findings, nil
+}
+
+// Minimize merges statements that differ only in their actions into the first such statement.
+func (s *IAMPolicyDoc) Minimize() {
+	groups := s.mergeableStatements()
+
+	if len(groups) == 0 {
+		return
+	}
+
+	drop := make(map[int]struct{})
+
+	for _, group := range groups {
+		var actions []interface{}
+
+		for _, i := range group {
+			for _, v := range iamPolicyStringList(s.Statements[i].Actions) {
+				actions = append(actions, v)
+			}
+
+			if i != group[0] {
+				drop[i] = struct{}{}
+			}
+		}
+
+		s.Statements[group[0]].Actions = iamPolicyDecodeConfigStringList(uniqueInterfaceStrings(actions))
+	}
+
+	var stmts []*IAMPolicyStatement
+
+	for i, stmt := range s.Statements {
+		if _, ok := drop[i]; !ok {
+			stmts = append(stmts, stmt)
+		}
+	}
+
+	s.Statements = stmts
+}
+
+// mergeableStatements returns the indices of statements that differ only in their actions,
+// grouped by statement and in document order.
+func (s *IAMPolicyDoc) mergeableStatements() [][]int {
+	var keys []string
+	groups := make(map[string][]int)
+
+	for i, stmt := range s.Statements {
+		if stmt.Actions == nil || stmt.NotActions != nil {
+			continue
+		}
+
+		key, err := policyStatementKeyWithoutActions(stmt)
+
+		if err != nil {
+			continue
+		}
+
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+
+		groups[key] = append(groups[key], i)
+	}
+
+	var result [][]int
+
+	for _, key := range keys {
+		if group := groups[key]; len(group) > 1 {
+			result = append(result, group)
+		}
+	}
+
+	return result
+}
+
+func (s *IAMPolicyDoc) compactSize() (int, error) {
+	b, err := json.Marshal(s)
+
+	if err != nil {
+		return 0, err
+	}
+
+	var buf bytes.Buffer
+
+	if err := json.Compact(&buf, b); err != nil {
+		return 0, err
+	}
+
+	return buf.Len(), nil
+}
+
+func policyStatementKeyWithoutActions(stmt *IAMPolicyStatement) (string, error) {
+	v := *stmt
+	v.Actions = nil
+	v.Resources = normalizedPolicyStringList(v.Resources)
+	v.NotResources = normalizedPolicyStringList(v.NotResources)
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func validPolicyConditionOperator(operator string) bool {
+	v := operator
+
+	for _, prefix := range []string{"ForAllValues:", "ForAnyValue:"} {
+		v = strings.TrimPrefix(v, prefix)
+	}
+
+	// Null is the only operator that can't be combined with IfExists.
+	if w := strings.TrimSuffix(v, "IfExists"); w != v {
+		if w == "Null" {
+			return false
+		}
+
+		v = w
+	}
+
+	_, ok := policyConditionOperators[v]
+
+	return ok
+}
+
+// policyResourceARNError validates a policy resource using verify.ValidARN.
+// Wildcards and policy variables are allowed in the region and account ID.
+func policyResourceARNError(resource string) error {
+	if resource == "*" {
+		return nil
+	}
+
+	parts := strings.SplitN(policyVariableRegexp.ReplaceAllString(resource, "*"), ":", 6)
+
+	for _, i := range []int{3, 4} {
+		if len(parts) > i && strings.ContainsAny(parts[i], "*?") {
+			parts[i] = ""
+		}
+	}
+
+	_, errs := verify.ValidARN(strings.Join(parts, ":"), "resources")
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%q is an invalid ARN", resource)
+	}
+
+	return nil
+}
+
+// policyBroadAction returns the first action in the list that grants all actions of a service, if any.
+func policyBroadAction(l []string) string {
+	for _, v := range l {
+		if v == "*" || strings.HasSuffix(v, ":*") {
+			return v
+		}
+	}
+
+	return ""
+}
+
+func policyListContainsWildcard(l []string) bool {
+	for _, v := range l {
+		if v == "*" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// iamPolicyStringList returns a policy element decoded from configuration or JSON as a []string.
+func iamPolicyStringList(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		l := make([]string, 0, len(v))
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				l = append(l, v)
+			}
+		}
+		return l
+	default:
+		return nil
+	}
+}
+
+func normalizedPolicyStringList(v interface{}) interface{} {
+	l := iamPolicyStringList(v)
+
+	if l == nil {
+		return nil
+	}
+
+	l = append([]string(nil), l...)
+	sort.Strings(l)
+
+	return l
+}
+
+func uniqueInterfaceStrings(l []interface{}) []interface{} {
+	var out []interface{}
+	seen := make(map[string]struct{})
+
+	for _, v := range l {
+		s := v.(string)
+
+		if _, ok := seen[s]; ok {
+			continue
+		}
+
+		seen[s] = struct{}{}
+		out = append(out, s)
+	}
+
+	return out
+}
+
+func intsString(l []int) string {
+	parts := make([]string, len(l))
+
+	for i, v := range l {
+		parts[i] = fmt.Sprint(v)
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,173 @@
+package iam
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestIAMPolicyDocLint(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Policy   string
+		Expected []string
+	}{
+		{
+			Name: "clean",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Read",
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::example/*",
+      "Condition": {"ForAnyValue:StringLikeIfExists": {"aws:PrincipalTag/team": "a*"}}
+    }
+  ]
+}`,
+		},
+		{
+			Name: "unknown condition operator",
+			Policy: `{
+  "Statement": [
+    {"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::example/*", "Condition": {"StringEqual": {"aws:username": "a"}}},
+    {"Effect": "Allow", "Action": "s3:PutObject", "Resource": "arn:aws:s3:::other/*", "Condition": {"NullIfExists": {"aws:username": "true"}}}
+  ]
+}`,
+			Expected: []string{
+				`statement 0: condition_operator: unknown condition operator "StringEqual"`,
+				`statement 1: condition_operator: unknown condition operator "NullIfExists"`,
+			},
+		},
+		{
+			Name: "malformed ARN",
+			Policy: `{
+  "Statement": [
+    {"Effect": "Allow", "Action": "ec2:StartInstances", "Resource": ["arn:aws:ec2:*:*:instance/*", "arn:aws:ec2:${aws:RequestedRegion}:123456789012:instance/*", "arn:aws:ec2:us-west-2:12345:instance/*", "my-bucket"]}
+  ]
+}`,
+			Expected: []string{
+				`statement 0: malformed_arn: "arn:aws:ec2:us-west-2:12345:instance/*" is an invalid ARN`,
+				`statement 0: malformed_arn: "my-bucket" is an invalid ARN`,
+			},
+		},
+		{
+			Name: "broad permissions",
+			Policy: `{
+  "Statement": [
+    {"Sid": "Admin", "Effect": "Allow", "Action": "*", "Resource": "*"},
+    {"Effect": "Allow", "Action": ["s3:*"], "Resource": ["*"]},
+    {"Effect": "Deny", "Action": "*", "Resource": "*"},
+    {"Effect": "Allow", "Action": "s3:*", "Resource": "arn:aws:s3:::example"}
+  ]
+}`,
+			Expected: []string{
+				`statement 0 (Admin): broad_permissions: allows "*" on all resources`,
+				`statement 1: broad_permissions: allows "s3:*" on all resources`,
+			},
+		},
+		{
+			Name: "duplicate Sid",
+			Policy: `{
+  "Statement": [
+    {"Sid": "A", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::one/*"},
+    {"Sid": "A", "Effect": "Allow", "Action": "s3:PutObject", "Resource": "arn:aws:s3:::two/*"}
+  ]
+}`,
+			Expected: []string{
+				`statement 1 (A): duplicate_sid: Sid is also used by statement 0`,
+			},
+		},
+		{
+			Name: "mergeable statements",
+			Policy: `{
+  "Statement": [
+    {"Effect": "Allow", "Action": "s3:GetObject", "Resource": ["arn:aws:s3:::one/*", "arn:aws:s3:::two/*"]},
+    {"Effect": "Allow", "Action": "sqs:SendMessage", "Resource": "arn:aws:sqs:us-west-2:123456789012:queue"},
+    {"Effect": "Allow", "Action": "s3:PutObject", "Resource": ["arn:aws:s3:::two/*", "arn:aws:s3:::one/*"]}
+  ]
+}`,
+			Expected: []string{
+				`statement 0: mergeable_statement: differs only in actions from statement(s) 2`,
+			},
+		},
+		{
+			Name:   "size",
+			Policy: `{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::` + strings.Repeat("a", policyDocumentMaxSize) + `"}]}`,
+			Expected: []string{
+				`statement -1: size: document is 6238 characters without white space, more than the 6144 allowed for managed policies`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := &IAMPolicyDoc{}
+
+			if err := json.Unmarshal([]byte(testCase.Policy), doc); err != nil {
+				t.Fatalf("unexpected error unmarshaling policy: %s", err)
+			}
+
+			findings, err := doc.Lint()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got []string
+			for _, finding := range findings {
+				got = append(got, finding.String())
+			}
+
+			if strings.Join(got, "\n") != strings.Join(testCase.Expected, "\n") {
+				t.Errorf("expected findings:\n%s\ngot:\n%s", strings.Join(testCase.Expected, "\n"), strings.Join(got, "\n"))
+			}
+		})
+	}
+}
+
+func TestIAMPolicyDocMinimize(t *testing.T) {
+	doc := &IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*IAMPolicyStatement{
+			{
+				Effect:    "Allow",
+				Actions:   "s3:GetObject",
+				Resources: []string{"arn:aws:s3:::two/*", "arn:aws:s3:::one/*"},
+			},
+			{
+				Effect:    "Allow",
+				Actions:   "sqs:SendMessage",
+				Resources: "arn:aws:sqs:us-west-2:123456789012:queue",
+			},
+			{
+				Effect:    "Allow",
+				Actions:   []string{"s3:PutObject", "s3:GetObject"},
+				Resources: []string{"arn:aws:s3:::one/*", "arn:aws:s3:::two/*"},
+			},
+			{
+				Effect:    "Deny",
+				Actions:   "s3:DeleteObject",
+				Resources: []string{"arn:aws:s3:::one/*", "arn:aws:s3:::two/*"},
+			},
+		},
+	}
+
+	doc.Minimize()
+
+	b, err := json.Marshal(doc)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"Version":"2012-10-17","Statement":[` +
+		`{"Sid":"","Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":["arn:aws:s3:::two/*","arn:aws:s3:::one/*"]},` +
+		`{"Sid":"","Effect":"Allow","Action":"sqs:SendMessage","Resource":"arn:aws:sqs:us-west-2:123456789012:queue"},` +
+		`{"Sid":"","Effect":"Deny","Action":"s3:DeleteObject","Resource":["arn:aws:s3:::one/*","arn:aws:s3:::two/*"]}]}`
+
+	if got := string(b); got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
@@ -0,0 +1,369 @@
+package iam
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourcePrincipalPolicySimulation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePrincipalPolicySimulationRead,
+
+		Schema: map[string]*schema.Schema{
+			"action_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(3, 128),
+				},
+			},
+			"additional_policies_json": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				AtLeastOneOf: []string{"additional_policies_json", "policy_source_arn"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidIAMPolicyJSON,
+				},
+			},
+			"all_allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"caller_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(5, 256),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(iam.ContextKeyTypeEnum_Values(), false),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"fail_on_deny": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"permissions_boundary_policies_json": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidIAMPolicyJSON,
+				},
+			},
+			"policy_source_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 2048),
+				},
+			},
+			"resource_handling_option": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"resource_owner_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"resource_policy_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIAMPolicyJSON,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allowed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"decision": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"decision_details": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"matched_statements": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source_policy_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_policy_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"missing_context_keys": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePrincipalPolicySimulationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	var results []*iam.EvaluationResult
+	fn := func(page *iam.SimulatePolicyResponse, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		results = append(results, page.EvaluationResults...)
+
+		return !lastPage
+	}
+
+	// A principal ARN selects SimulatePrincipalPolicy, which evaluates the principal's
+	// attached policies together with any additional policies. Without one, the
+	// additional policies are evaluated on their own using SimulateCustomPolicy.
+	if v, ok := d.GetOk("policy_source_arn"); ok {
+		input := &iam.SimulatePrincipalPolicyInput{
+			ActionNames:     flex.ExpandStringSet(d.Get("action_names").(*schema.Set)),
+			PolicySourceArn: aws.String(v.(string)),
+		}
+
+		if v, ok := d.GetOk("additional_policies_json"); ok && v.(*schema.Set).Len() > 0 {
+			input.PolicyInputList = flex.ExpandStringSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("caller_arn"); ok {
+			input.CallerArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("context"); ok && v.(*schema.Set).Len() > 0 {
+			input.ContextEntries = expandSimulationContextEntries(v.(*schema.Set).List())
+		}
+
+		if v, ok := d.GetOk("permissions_boundary_policies_json"); ok && v.(*schema.Set).Len() > 0 {
+			input.PermissionsBoundaryPolicyInputList = flex.ExpandStringSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("resource_arns"); ok && v.(*schema.Set).Len() > 0 {
+			input.ResourceArns = flex.ExpandStringSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("resource_handling_option"); ok {
+			input.ResourceHandlingOption = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("resource_owner_account_id"); ok {
+			input.ResourceOwner = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("resource_policy_json"); ok {
+			input.ResourcePolicy = aws.String(v.(string))
+		}
+
+		if err := conn.SimulatePrincipalPolicyPages(input, fn); err != nil {
+			return fmt.Errorf("error simulating IAM principal policy (%s): %w", v.(string), err)
+		}
+	} else {
+		input := &iam.SimulateCustomPolicyInput{
+			ActionNames:     flex.ExpandStringSet(d.Get("action_names").(*schema.Set)),
+			PolicyInputList: flex.ExpandStringSet(d.Get("additional_policies_json").(*schema.Set)),
+		}
+
+		if v, ok := d.GetOk("caller_arn"); ok {
+			input.CallerArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("context"); ok && v.(*schema.Set).Len() > 0 {
+			input.ContextEntries = expandSimulationContextEntries(v.(*schema.Set).List())
+		}
+
+		if v, ok := d.GetOk("permissions_boundary_policies_json"); ok && v.(*schema.Set).Len() > 0 {
+			input.PermissionsBoundaryPolicyInputList = flex.ExpandStringSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("resource_arns"); ok && v.(*schema.Set).Len() > 0 {
+			input.ResourceArns = flex.ExpandStringSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("resource_handling_option"); ok {
+			input.ResourceHandlingOption = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("resource_owner_account_id"); ok {
+			input.ResourceOwner = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("resource_policy_json"); ok {
+			input.ResourcePolicy = aws.String(v.(string))
+		}
+
+		if err := conn.SimulateCustomPolicyPages(input, fn); err != nil {
+			return fmt.Errorf("error simulating IAM custom policy: %w", err)
+		}
+	}
+
+	tfList, denied := flattenSimulationEvaluationResults(results)
+
+	d.SetId(resource.UniqueId())
+	d.Set("all_allowed", len(denied) == 0)
+	if err := d.Set("results", tfList); err != nil {
+		return fmt.Errorf("error setting results: %w", err)
+	}
+
+	if d.Get("fail_on_deny").(bool) && len(denied) > 0 {
+		return fmt.Errorf("IAM policy simulation denied %d action(s):\n\t%s", len(denied), strings.Join(denied, "\n\t"))
+	}
+
+	return nil
+}
+
+func expandSimulationContextEntries(tfList []interface{}) []*iam.ContextEntry {
+	var apiObjects []*iam.ContextEntry
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iam.ContextEntry{
+			ContextKeyName: aws.String(tfMap["key"].(string)),
+			ContextKeyType: aws.String(tfMap["type"].(string)),
+		}
+
+		if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ContextKeyValues = flex.ExpandStringSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// flattenSimulationEvaluationResults returns one result per action and resource pair,
+// along with a description of each pair that was not allowed.
+func flattenSimulationEvaluationResults(apiObjects []*iam.EvaluationResult) ([]interface{}, []string) {
+	var tfList []interface{}
+	var denied []string
+
+	add := func(action, resource, decision string, details map[string]*string, statements []*iam.Statement, missing []*string) {
+		tfList = append(tfList, map[string]interface{}{
+			"action_name":          action,
+			"allowed":              decision == iam.PolicyEvaluationDecisionTypeAllowed,
+			"decision":             decision,
+			"decision_details":     aws.StringValueMap(details),
+			"matched_statements":   flattenSimulationStatements(statements),
+			"missing_context_keys": aws.StringValueSlice(missing),
+			"resource_arn":         resource,
+		})
+
+		if decision != iam.PolicyEvaluationDecisionTypeAllowed {
+			denied = append(denied, fmt.Sprintf("%s on %s: %s", action, resource, decision))
+		}
+	}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		action := aws.StringValue(apiObject.EvalActionName)
+
+		if len(apiObject.ResourceSpecificResults) == 0 {
+			add(action, aws.StringValue(apiObject.EvalResourceName), aws.StringValue(apiObject.EvalDecision), apiObject.EvalDecisionDetails, apiObject.MatchedStatements, apiObject.MissingContextValues)
+
+			continue
+		}
+
+		for _, v := range apiObject.ResourceSpecificResults {
+			if v == nil {
+				continue
+			}
+
+			add(action, aws.StringValue(v.EvalResourceName), aws.StringValue(v.EvalResourceDecision), v.EvalDecisionDetails, v.MatchedStatements, v.MissingContextValues)
+		}
+	}
+
+	sort.Strings(denied)
+
+	return tfList, denied
+}
+
+func flattenSimulationStatements(apiObjects []*iam.Statement) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"source_policy_id":   aws.StringValue(apiObject.SourcePolicyId),
+			"source_policy_type": aws.StringValue(apiObject.SourcePolicyType),
+		})
+	}
+
+	return tfList
+}
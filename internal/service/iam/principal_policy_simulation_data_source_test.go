@@ -0,0 +1,153 @@
+package iam_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPrincipalPolicySimulationDataSource_custom(t *testing.T) {
+	dataSourceName := "data.aws_iam_principal_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrincipalPolicySimulationDataSourceConfig_custom("s3:GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.action_name", "s3:GetObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", iam.PolicyEvaluationDecisionTypeAllowed),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.#", "1"),
+				),
+			},
+			{
+				Config: testAccPrincipalPolicySimulationDataSourceConfig_custom("s3:PutObject"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", iam.PolicyEvaluationDecisionTypeImplicitDeny),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPrincipalPolicySimulationDataSource_failOnDeny(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPrincipalPolicySimulationDataSourceConfig_failOnDeny,
+				ExpectError: regexp.MustCompile(`IAM policy simulation denied 1 action\(s\)`),
+			},
+		},
+	})
+}
+
+func TestAccIAMPrincipalPolicySimulationDataSource_principal(t *testing.T) {
+	dataSourceName := "data.aws_iam_principal_policy_simulation.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrincipalPolicySimulationDataSourceConfig_principal(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "results.*", map[string]string{
+						"action_name": "sqs:SendMessage",
+						"allowed":     "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "results.*", map[string]string{
+						"action_name": "sqs:DeleteQueue",
+						"decision":    iam.PolicyEvaluationDecisionTypeExplicitDeny,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccPrincipalPolicySimulationDataSourceConfig_custom(action string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_principal_policy_simulation" "test" {
+  action_names             = [%[1]q]
+  additional_policies_json = [data.aws_iam_policy_document.test.json]
+}
+`, action)
+}
+
+const testAccPrincipalPolicySimulationDataSourceConfig_failOnDeny = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_principal_policy_simulation" "test" {
+  action_names             = ["s3:GetObject", "s3:DeleteBucket"]
+  additional_policies_json = [data.aws_iam_policy_document.test.json]
+  fail_on_deny             = true
+}
+`
+
+func testAccPrincipalPolicySimulationDataSourceConfig_principal(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_user_policy" "test" {
+  name = %[1]q
+  user = aws_iam_user.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect   = "Allow"
+        Action   = ["sqs:SendMessage", "sqs:DeleteQueue"]
+        Resource = "*"
+      },
+      {
+        Effect   = "Deny"
+        Action   = "sqs:DeleteQueue"
+        Resource = "*"
+      },
+    ]
+  })
+}
+
+data "aws_iam_principal_policy_simulation" "test" {
+  action_names      = ["sqs:SendMessage", "sqs:DeleteQueue"]
+  policy_source_arn = aws_iam_user.test.arn
+
+  depends_on = [aws_iam_user_policy.test]
+}
+`, rName)
+}
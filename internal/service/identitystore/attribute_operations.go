@@ -0,0 +1,85 @@
+package identitystore
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+)
+
+// attributeOperation is an UpdateUser or UpdateGroup operation.
+// The AWS SDK for Go v1 does not support document types, so its AttributeOperation has no AttributeValue.
+type attributeOperation struct {
+	AttributePath  string      `json:"AttributePath"`
+	AttributeValue interface{} `json:"AttributeValue,omitempty"`
+}
+
+// expandAttributeOperation returns an operation setting the attribute at the specified path.
+// Empty values remove the attribute.
+func expandAttributeOperation(path string, value interface{}) attributeOperation {
+	operation := attributeOperation{
+		AttributePath: path,
+	}
+
+	if v, ok := value.(string); !ok || v != "" {
+		operation.AttributeValue = value
+	}
+
+	return operation
+}
+
+func expandAttributeOperations(operations []attributeOperation) []*identitystore.AttributeOperation {
+	var apiObjects []*identitystore.AttributeOperation
+
+	for _, operation := range operations {
+		apiObjects = append(apiObjects, &identitystore.AttributeOperation{
+			AttributePath: aws.String(operation.AttributePath),
+		})
+	}
+
+	return apiObjects
+}
+
+// withAttributeValues returns a request option that replaces the operations in the
+// request body with the specified operations, including their attribute values.
+func withAttributeValues(operations []attributeOperation) request.Option {
+	return func(r *request.Request) {
+		r.Handlers.Build.PushBack(func(r *request.Request) {
+			b, err := io.ReadAll(r.GetBody())
+
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			body := make(map[string]interface{})
+
+			if err := json.Unmarshal(b, &body); err != nil {
+				r.Error = err
+				return
+			}
+
+			body["Operations"] = operations
+
+			if b, err = json.Marshal(body); err != nil {
+				r.Error = err
+				return
+			}
+
+			r.SetReaderBody(bytes.NewReader(b))
+		})
+	}
+}
+
+// expandListAttributeOperation returns an operation replacing the multi-valued attribute at the specified path.
+// An empty list removes the attribute.
+func expandListAttributeOperation(path string, value []interface{}) attributeOperation {
+	if len(value) == 0 {
+		return expandAttributeOperation(path, "")
+	}
+
+	return expandAttributeOperation(path, value)
+}
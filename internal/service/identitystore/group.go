@@ -0,0 +1,237 @@
+package identitystore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupCreate,
+		ReadWithoutTimeout:   resourceGroupRead,
+		UpdateWithoutTimeout: resourceGroupUpdate,
+		DeleteWithoutTimeout: resourceGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"external_ids": externalIDsSchema(),
+			"group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity_store_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validIdentityStoreID,
+			},
+		},
+	}
+}
+
+func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	input := &identitystore.CreateGroupInput{
+		DisplayName:     aws.String(d.Get("display_name").(string)),
+		IdentityStoreId: aws.String(identityStoreID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Identity Store Group: %s", aws.StringValue(input.DisplayName))
+	output, err := conn.CreateGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Identity Store Group (%s): %s", aws.StringValue(input.DisplayName), err)
+	}
+
+	d.SetId(GroupCreateResourceID(identityStoreID, aws.StringValue(output.GroupId)))
+
+	return resourceGroupRead(ctx, d, meta)
+}
+
+func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, groupID, err := GroupParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindGroupByTwoPartKey(ctx, conn, identityStoreID, groupID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Identity Store Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Identity Store Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("description", output.Description)
+	d.Set("display_name", output.DisplayName)
+	if err := d.Set("external_ids", flattenExternalIDs(output.ExternalIds)); err != nil {
+		return diag.Errorf("error setting external_ids: %s", err)
+	}
+	d.Set("group_id", output.GroupId)
+	d.Set("identity_store_id", output.IdentityStoreId)
+
+	return nil
+}
+
+func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, groupID, err := GroupParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var operations []attributeOperation
+
+	if d.HasChange("description") {
+		operations = append(operations, expandAttributeOperation("description", d.Get("description").(string)))
+	}
+
+	if d.HasChange("display_name") {
+		operations = append(operations, expandAttributeOperation("displayName", d.Get("display_name").(string)))
+	}
+
+	input := &identitystore.UpdateGroupInput{
+		GroupId:         aws.String(groupID),
+		IdentityStoreId: aws.String(identityStoreID),
+		Operations:      expandAttributeOperations(operations),
+	}
+
+	log.Printf("[DEBUG] Updating Identity Store Group: %s", d.Id())
+	_, err = conn.UpdateGroupWithContext(ctx, input, withAttributeValues(operations))
+
+	if err != nil {
+		return diag.Errorf("error updating Identity Store Group (%s): %s", d.Id(), err)
+	}
+
+	return resourceGroupRead(ctx, d, meta)
+}
+
+func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, groupID, err := GroupParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Identity Store Group: %s", d.Id())
+	_, err = conn.DeleteGroupWithContext(ctx, &identitystore.DeleteGroupInput{
+		GroupId:         aws.String(groupID),
+		IdentityStoreId: aws.String(identityStoreID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, identitystore.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Identity Store Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const groupResourceIDSeparator = "/"
+
+func GroupCreateResourceID(identityStoreID, groupID string) string {
+	parts := []string{identityStoreID, groupID}
+	id := strings.Join(parts, groupResourceIDSeparator)
+
+	return id
+}
+
+func GroupParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, groupResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected IDENTITY_STORE_ID%[2]sGROUP_ID", id, groupResourceIDSeparator)
+}
+
+var validIdentityStoreID = validation.All(
+	validation.StringLenBetween(1, 64),
+	validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]*$`), "must match [a-zA-Z0-9-]"),
+)
+
+func externalIDsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"issuer": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func flattenExternalIDs(apiObjects []*identitystore.ExternalId) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"id":     aws.StringValue(apiObject.Id),
+			"issuer": aws.StringValue(apiObject.Issuer),
+		})
+	}
+
+	return tfList
+}
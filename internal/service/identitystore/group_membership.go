@@ -0,0 +1,154 @@
+package identitystore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupMembershipCreate,
+		ReadWithoutTimeout:   resourceGroupMembershipRead,
+		DeleteWithoutTimeout: resourceGroupMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 47),
+			},
+			"identity_store_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validIdentityStoreID,
+			},
+			"member_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 47),
+			},
+			"membership_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGroupMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	groupID := d.Get("group_id").(string)
+	memberID := d.Get("member_id").(string)
+	input := &identitystore.CreateGroupMembershipInput{
+		GroupId:         aws.String(groupID),
+		IdentityStoreId: aws.String(identityStoreID),
+		MemberId: &identitystore.MemberId{
+			UserId: aws.String(memberID),
+		},
+	}
+
+	log.Printf("[DEBUG] Creating Identity Store Group Membership: group %s, member %s", groupID, memberID)
+	output, err := conn.CreateGroupMembershipWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Identity Store Group (%s) Membership (%s): %s", groupID, memberID, err)
+	}
+
+	d.SetId(GroupMembershipCreateResourceID(identityStoreID, aws.StringValue(output.MembershipId)))
+
+	return resourceGroupMembershipRead(ctx, d, meta)
+}
+
+func resourceGroupMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, membershipID, err := GroupMembershipParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindGroupMembershipByTwoPartKey(ctx, conn, identityStoreID, membershipID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Identity Store Group Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Identity Store Group Membership (%s): %s", d.Id(), err)
+	}
+
+	d.Set("group_id", output.GroupId)
+	d.Set("identity_store_id", output.IdentityStoreId)
+	d.Set("member_id", output.MemberId.UserId)
+	d.Set("membership_id", output.MembershipId)
+
+	return nil
+}
+
+func resourceGroupMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, membershipID, err := GroupMembershipParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Identity Store Group Membership: %s", d.Id())
+	_, err = conn.DeleteGroupMembershipWithContext(ctx, &identitystore.DeleteGroupMembershipInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		MembershipId:    aws.String(membershipID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, identitystore.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Identity Store Group Membership (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const groupMembershipResourceIDSeparator = "/"
+
+func GroupMembershipCreateResourceID(identityStoreID, membershipID string) string {
+	parts := []string{identityStoreID, membershipID}
+	id := strings.Join(parts, groupMembershipResourceIDSeparator)
+
+	return id
+}
+
+func GroupMembershipParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, groupMembershipResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected IDENTITY_STORE_ID%[2]sMEMBERSHIP_ID", id, groupMembershipResourceIDSeparator)
+}
@@ -0,0 +1,103 @@
+package identitystore
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": filtersSchema(),
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"external_ids": externalIDsSchema(),
+						"group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"identity_store_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validIdentityStoreID,
+			},
+		},
+	}
+}
+
+func dataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	input := &identitystore.ListGroupsInput{
+		IdentityStoreId: aws.String(identityStoreID),
+	}
+
+	if v, ok := d.GetOk("filter"); ok && v.(*schema.Set).Len() > 0 {
+		input.Filters = expandIdentityStoreFilters(v.(*schema.Set).List())
+	}
+
+	results, err := findGroups(ctx, conn, input)
+
+	if err != nil {
+		return diag.Errorf("error listing Identity Store Groups: %s", err)
+	}
+
+	var tfList []interface{}
+
+	for _, group := range results {
+		tfList = append(tfList, map[string]interface{}{
+			"description":  aws.StringValue(group.Description),
+			"display_name": aws.StringValue(group.DisplayName),
+			"external_ids": flattenExternalIDs(group.ExternalIds),
+			"group_id":     aws.StringValue(group.GroupId),
+		})
+	}
+
+	d.SetId(identityStoreID)
+	if err := d.Set("groups", tfList); err != nil {
+		return diag.Errorf("error setting groups: %s", err)
+	}
+
+	return nil
+}
+
+func filtersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"attribute_path": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"attribute_value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
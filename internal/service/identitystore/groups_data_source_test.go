@@ -0,0 +1,47 @@
+package identitystore_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIdentityStoreGroupsDataSource_filter(t *testing.T) {
+	dataSourceName := "data.aws_identitystore_groups.test"
+	resourceName := "aws_identitystore_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckSSOAdminInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, identitystore.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "groups.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "groups.0.group_id", resourceName, "group_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "groups.0.display_name", resourceName, "display_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "groups.0.description", resourceName, "description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGroupsDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccGroupConfig(rName, "Acceptance Test"), `
+data "aws_identitystore_groups" "test" {
+  identity_store_id = aws_identitystore_group.test.identity_store_id
+
+  filter {
+    attribute_path  = "DisplayName"
+    attribute_value = aws_identitystore_group.test.display_name
+  }
+}
+`)
+}
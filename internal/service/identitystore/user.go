@@ -0,0 +1,738 @@
+package identitystore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceUser() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUserCreate,
+		ReadWithoutTimeout:   resourceUserRead,
+		UpdateWithoutTimeout: resourceUserUpdate,
+		DeleteWithoutTimeout: resourceUserDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"addresses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"country": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"formatted": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"locality": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"postal_code": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"primary": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"region": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"street_address": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"emails": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"primary": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"external_ids": externalIDsSchema(),
+			"identity_store_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validIdentityStoreID,
+			},
+			"locale": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"name": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"family_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"formatted": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"given_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"honorific_prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"honorific_suffix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"middle_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"nickname": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"phone_numbers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"primary": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"preferred_language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"profile_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"timezone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"title": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"user_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+	}
+}
+
+// userStringAttributes maps top-level string arguments to their Identity Store attribute paths.
+var userStringAttributes = map[string]string{
+	"display_name":       "displayName",
+	"locale":             "locale",
+	"nickname":           "nickName",
+	"preferred_language": "preferredLanguage",
+	"profile_url":        "profileUrl",
+	"timezone":           "timezone",
+	"title":              "title",
+	"user_type":          "userType",
+}
+
+// userNameAttributes maps name block arguments to their Identity Store attribute paths.
+var userNameAttributes = map[string]string{
+	"family_name":      "name.familyName",
+	"formatted":        "name.formatted",
+	"given_name":       "name.givenName",
+	"honorific_prefix": "name.honorificPrefix",
+	"honorific_suffix": "name.honorificSuffix",
+	"middle_name":      "name.middleName",
+}
+
+func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	input := &identitystore.CreateUserInput{
+		DisplayName:     aws.String(d.Get("display_name").(string)),
+		IdentityStoreId: aws.String(identityStoreID),
+		UserName:        aws.String(d.Get("user_name").(string)),
+	}
+
+	if v, ok := d.GetOk("addresses"); ok && len(v.([]interface{})) > 0 {
+		input.Addresses = expandAddresses(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("emails"); ok && len(v.([]interface{})) > 0 {
+		input.Emails = expandEmails(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		input.Locale = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Name = expandName(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("nickname"); ok {
+		input.NickName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("phone_numbers"); ok && len(v.([]interface{})) > 0 {
+		input.PhoneNumbers = expandPhoneNumbers(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("preferred_language"); ok {
+		input.PreferredLanguage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("profile_url"); ok {
+		input.ProfileUrl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("timezone"); ok {
+		input.Timezone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("title"); ok {
+		input.Title = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("user_type"); ok {
+		input.UserType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Identity Store User: %s", aws.StringValue(input.UserName))
+	output, err := conn.CreateUserWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Identity Store User (%s): %s", aws.StringValue(input.UserName), err)
+	}
+
+	d.SetId(UserCreateResourceID(identityStoreID, aws.StringValue(output.UserId)))
+
+	return resourceUserRead(ctx, d, meta)
+}
+
+func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, userID, err := UserParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindUserByTwoPartKey(ctx, conn, identityStoreID, userID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Identity Store User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Identity Store User (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("addresses", flattenAddresses(output.Addresses)); err != nil {
+		return diag.Errorf("error setting addresses: %s", err)
+	}
+	d.Set("display_name", output.DisplayName)
+	if err := d.Set("emails", flattenEmails(output.Emails)); err != nil {
+		return diag.Errorf("error setting emails: %s", err)
+	}
+	if err := d.Set("external_ids", flattenExternalIDs(output.ExternalIds)); err != nil {
+		return diag.Errorf("error setting external_ids: %s", err)
+	}
+	d.Set("identity_store_id", output.IdentityStoreId)
+	d.Set("locale", output.Locale)
+	if err := d.Set("name", flattenName(output.Name)); err != nil {
+		return diag.Errorf("error setting name: %s", err)
+	}
+	d.Set("nickname", output.NickName)
+	if err := d.Set("phone_numbers", flattenPhoneNumbers(output.PhoneNumbers)); err != nil {
+		return diag.Errorf("error setting phone_numbers: %s", err)
+	}
+	d.Set("preferred_language", output.PreferredLanguage)
+	d.Set("profile_url", output.ProfileUrl)
+	d.Set("timezone", output.Timezone)
+	d.Set("title", output.Title)
+	d.Set("user_id", output.UserId)
+	d.Set("user_name", output.UserName)
+	d.Set("user_type", output.UserType)
+
+	return nil
+}
+
+func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, userID, err := UserParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var operations []attributeOperation
+
+	for key, path := range userStringAttributes {
+		if d.HasChange(key) {
+			operations = append(operations, expandAttributeOperation(path, d.Get(key).(string)))
+		}
+	}
+
+	for key, path := range userNameAttributes {
+		if k := "name.0." + key; d.HasChange(k) {
+			operations = append(operations, expandAttributeOperation(path, d.Get(k).(string)))
+		}
+	}
+
+	if d.HasChange("addresses") {
+		operations = append(operations, expandListAttributeOperation("addresses", addressesAttributeValue(d.Get("addresses").([]interface{}))))
+	}
+
+	if d.HasChange("emails") {
+		operations = append(operations, expandListAttributeOperation("emails", contactsAttributeValue(d.Get("emails").([]interface{}))))
+	}
+
+	if d.HasChange("phone_numbers") {
+		operations = append(operations, expandListAttributeOperation("phoneNumbers", contactsAttributeValue(d.Get("phone_numbers").([]interface{}))))
+	}
+
+	if len(operations) > 0 {
+		input := &identitystore.UpdateUserInput{
+			IdentityStoreId: aws.String(identityStoreID),
+			Operations:      expandAttributeOperations(operations),
+			UserId:          aws.String(userID),
+		}
+
+		log.Printf("[DEBUG] Updating Identity Store User: %s", d.Id())
+		_, err = conn.UpdateUserWithContext(ctx, input, withAttributeValues(operations))
+
+		if err != nil {
+			return diag.Errorf("error updating Identity Store User (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceUserRead(ctx, d, meta)
+}
+
+func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, userID, err := UserParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Identity Store User: %s", d.Id())
+	_, err = conn.DeleteUserWithContext(ctx, &identitystore.DeleteUserInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		UserId:          aws.String(userID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, identitystore.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Identity Store User (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const userResourceIDSeparator = "/"
+
+func UserCreateResourceID(identityStoreID, userID string) string {
+	parts := []string{identityStoreID, userID}
+	id := strings.Join(parts, userResourceIDSeparator)
+
+	return id
+}
+
+func UserParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, userResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected IDENTITY_STORE_ID%[2]sUSER_ID", id, userResourceIDSeparator)
+}
+
+// addressesAttributeValue returns the addresses attribute value in the Identity Store wire format.
+func addressesAttributeValue(tfList []interface{}) []interface{} {
+	var value []interface{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		v := map[string]interface{}{
+			"primary": tfMap["primary"].(bool),
+		}
+
+		for key, path := range map[string]string{
+			"country":        "country",
+			"formatted":      "formatted",
+			"locality":       "locality",
+			"postal_code":    "postalCode",
+			"region":         "region",
+			"street_address": "streetAddress",
+			"type":           "type",
+		} {
+			if s, ok := tfMap[key].(string); ok && s != "" {
+				v[path] = s
+			}
+		}
+
+		value = append(value, v)
+	}
+
+	return value
+}
+
+// contactsAttributeValue returns the emails or phoneNumbers attribute value in the Identity Store wire format.
+func contactsAttributeValue(tfList []interface{}) []interface{} {
+	var value []interface{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		v := map[string]interface{}{
+			"primary": tfMap["primary"].(bool),
+		}
+
+		if s, ok := tfMap["type"].(string); ok && s != "" {
+			v["type"] = s
+		}
+
+		if s, ok := tfMap["value"].(string); ok && s != "" {
+			v["value"] = s
+		}
+
+		value = append(value, v)
+	}
+
+	return value
+}
+
+func expandAddresses(tfList []interface{}) []*identitystore.Address {
+	var apiObjects []*identitystore.Address
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &identitystore.Address{
+			Primary: aws.Bool(tfMap["primary"].(bool)),
+		}
+
+		if v, ok := tfMap["country"].(string); ok && v != "" {
+			apiObject.Country = aws.String(v)
+		}
+
+		if v, ok := tfMap["formatted"].(string); ok && v != "" {
+			apiObject.Formatted = aws.String(v)
+		}
+
+		if v, ok := tfMap["locality"].(string); ok && v != "" {
+			apiObject.Locality = aws.String(v)
+		}
+
+		if v, ok := tfMap["postal_code"].(string); ok && v != "" {
+			apiObject.PostalCode = aws.String(v)
+		}
+
+		if v, ok := tfMap["region"].(string); ok && v != "" {
+			apiObject.Region = aws.String(v)
+		}
+
+		if v, ok := tfMap["street_address"].(string); ok && v != "" {
+			apiObject.StreetAddress = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAddresses(apiObjects []*identitystore.Address) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"country":        aws.StringValue(apiObject.Country),
+			"formatted":      aws.StringValue(apiObject.Formatted),
+			"locality":       aws.StringValue(apiObject.Locality),
+			"postal_code":    aws.StringValue(apiObject.PostalCode),
+			"primary":        aws.BoolValue(apiObject.Primary),
+			"region":         aws.StringValue(apiObject.Region),
+			"street_address": aws.StringValue(apiObject.StreetAddress),
+			"type":           aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func expandEmails(tfList []interface{}) []*identitystore.Email {
+	var apiObjects []*identitystore.Email
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &identitystore.Email{
+			Primary: aws.Bool(tfMap["primary"].(bool)),
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenEmails(apiObjects []*identitystore.Email) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"primary": aws.BoolValue(apiObject.Primary),
+			"type":    aws.StringValue(apiObject.Type),
+			"value":   aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func expandName(tfMap map[string]interface{}) *identitystore.Name {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &identitystore.Name{}
+
+	if v, ok := tfMap["family_name"].(string); ok && v != "" {
+		apiObject.FamilyName = aws.String(v)
+	}
+
+	if v, ok := tfMap["formatted"].(string); ok && v != "" {
+		apiObject.Formatted = aws.String(v)
+	}
+
+	if v, ok := tfMap["given_name"].(string); ok && v != "" {
+		apiObject.GivenName = aws.String(v)
+	}
+
+	if v, ok := tfMap["honorific_prefix"].(string); ok && v != "" {
+		apiObject.HonorificPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["honorific_suffix"].(string); ok && v != "" {
+		apiObject.HonorificSuffix = aws.String(v)
+	}
+
+	if v, ok := tfMap["middle_name"].(string); ok && v != "" {
+		apiObject.MiddleName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenName(apiObject *identitystore.Name) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"family_name":      aws.StringValue(apiObject.FamilyName),
+		"formatted":        aws.StringValue(apiObject.Formatted),
+		"given_name":       aws.StringValue(apiObject.GivenName),
+		"honorific_prefix": aws.StringValue(apiObject.HonorificPrefix),
+		"honorific_suffix": aws.StringValue(apiObject.HonorificSuffix),
+		"middle_name":      aws.StringValue(apiObject.MiddleName),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandPhoneNumbers(tfList []interface{}) []*identitystore.PhoneNumber {
+	var apiObjects []*identitystore.PhoneNumber
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &identitystore.PhoneNumber{
+			Primary: aws.Bool(tfMap["primary"].(bool)),
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenPhoneNumbers(apiObjects []*identitystore.PhoneNumber) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"primary": aws.BoolValue(apiObject.Primary),
+			"type":    aws.StringValue(apiObject.Type),
+			"value":   aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,138 @@
+package identitystore
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": filtersSchema(),
+			"identity_store_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validIdentityStoreID,
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"emails": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"primary": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"external_ids": externalIDsSchema(),
+						"name": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"family_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"formatted": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"given_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"honorific_prefix": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"honorific_suffix": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"middle_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	input := &identitystore.ListUsersInput{
+		IdentityStoreId: aws.String(identityStoreID),
+	}
+
+	if v, ok := d.GetOk("filter"); ok && v.(*schema.Set).Len() > 0 {
+		input.Filters = expandIdentityStoreFilters(v.(*schema.Set).List())
+	}
+
+	results, err := findUsers(ctx, conn, input)
+
+	if err != nil {
+		return diag.Errorf("error listing Identity Store Users: %s", err)
+	}
+
+	var tfList []interface{}
+
+	for _, user := range results {
+		tfList = append(tfList, map[string]interface{}{
+			"display_name": aws.StringValue(user.DisplayName),
+			"emails":       flattenEmails(user.Emails),
+			"external_ids": flattenExternalIDs(user.ExternalIds),
+			"name":         flattenName(user.Name),
+			"user_id":      aws.StringValue(user.UserId),
+			"user_name":    aws.StringValue(user.UserName),
+		})
+	}
+
+	d.SetId(identityStoreID)
+	if err := d.Set("users", tfList); err != nil {
+		return diag.Errorf("error setting users: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package identitystore_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIdentityStoreUsersDataSource_filter(t *testing.T) {
+	dataSourceName := "data.aws_identitystore_users.test"
+	resourceName := "aws_identitystore_user.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckSSOAdminInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, identitystore.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "users.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "users.0.user_id", resourceName, "user_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "users.0.user_name", resourceName, "user_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "users.0.display_name", resourceName, "display_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.name.0.family_name", "Doe"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUsersDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccUserConfig(rName), `
+data "aws_identitystore_users" "test" {
+  identity_store_id = aws_identitystore_user.test.identity_store_id
+
+  filter {
+    attribute_path  = "UserName"
+    attribute_value = aws_identitystore_user.test.user_name
+  }
+}
+`)
+}
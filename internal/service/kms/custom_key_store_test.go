@@ -0,0 +1,173 @@
+package kms_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Custom key stores require an initialized AWS CloudHSM cluster with two active HSMs and a kmsuser crypto user,
+// which cannot be created by Terraform. The tests are skipped unless an existing cluster is configured.
+func testAccCustomKeyStorePreCheck(t *testing.T) (string, string, string) {
+	clusterID := os.Getenv("KMS_CUSTOM_KEY_STORE_CLUSTER_ID")
+	password := os.Getenv("KMS_CUSTOM_KEY_STORE_PASSWORD")
+	trustAnchorPath := os.Getenv("KMS_CUSTOM_KEY_STORE_TRUST_ANCHOR_PATH")
+
+	if clusterID == "" || password == "" || trustAnchorPath == "" {
+		t.Skip("Environment variables KMS_CUSTOM_KEY_STORE_CLUSTER_ID, KMS_CUSTOM_KEY_STORE_PASSWORD and KMS_CUSTOM_KEY_STORE_TRUST_ANCHOR_PATH are not set")
+	}
+
+	trustAnchor, err := os.ReadFile(trustAnchorPath)
+
+	if err != nil {
+		t.Fatalf("error reading trust anchor certificate: %s", err)
+	}
+
+	return clusterID, password, string(trustAnchor)
+}
+
+func TestAccKMSCustomKeyStore_basic(t *testing.T) {
+	var keyStore kms.CustomKeyStoresListEntry
+	clusterID, password, trustAnchor := testAccCustomKeyStorePreCheck(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_custom_key_store.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomKeyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConfig_basic(rName, clusterID, password, trustAnchor, kms.ConnectionStateTypeDisconnected),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &keyStore),
+					resource.TestCheckResourceAttr(resourceName, "cloud_hsm_cluster_id", clusterID),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeDisconnected),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key_store_password"},
+			},
+			{
+				Config: testAccCustomKeyStoreConfig_basic(rName+"-updated", clusterID, password, trustAnchor, kms.ConnectionStateTypeDisconnected),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &keyStore),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_name", rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSCustomKeyStore_connectionState(t *testing.T) {
+	var keyStore kms.CustomKeyStoresListEntry
+	clusterID, password, trustAnchor := testAccCustomKeyStorePreCheck(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_custom_key_store.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomKeyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConfig_basic(rName, clusterID, password, trustAnchor, kms.ConnectionStateTypeConnected),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &keyStore),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeConnected),
+				),
+			},
+			{
+				Config: testAccCustomKeyStoreConfig_basic(rName, clusterID, password, trustAnchor, kms.ConnectionStateTypeDisconnected),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &keyStore),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeDisconnected),
+				),
+			},
+			{
+				Config: testAccCustomKeyStoreConfig_basic(rName, clusterID, password, trustAnchor, kms.ConnectionStateTypeConnected),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &keyStore),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeConnected),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCustomKeyStoreDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kms_custom_key_store" {
+			continue
+		}
+
+		_, err := tfkms.FindCustomKeyStoreByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("KMS Custom Key Store %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCustomKeyStoreExists(name string, v *kms.CustomKeyStoresListEntry) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No KMS Custom Key Store ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn
+
+		output, err := tfkms.FindCustomKeyStoreByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCustomKeyStoreConfig_basic(rName, clusterID, password, trustAnchor, connectionState string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  cloud_hsm_cluster_id     = %[2]q
+  connection_state         = %[5]q
+  custom_key_store_name    = %[1]q
+  key_store_password       = %[3]q
+  trust_anchor_certificate = %[4]q
+}
+`, rName, clusterID, password, trustAnchor, connectionState)
+}
@@ -0,0 +1,279 @@
+package kms
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+// Statement IDs used by the default key policies created by the AWS KMS console.
+// See https://docs.aws.amazon.com/kms/latest/developerguide/key-policy-default.html.
+const (
+	keyPolicySidAdministrators = "Allow access for Key Administrators"
+	keyPolicySidGrants         = "Allow attachment of persistent resources"
+	keyPolicySidRootAccess     = "Enable IAM User Permissions"
+	keyPolicySidUsers          = "Allow use of the key"
+)
+
+const (
+	keyPolicyVersion = "2012-10-17"
+)
+
+type keyPolicyServicePrincipal struct {
+	Actions        []string
+	Identifiers    []string
+	Sid            string
+	SourceAccounts []string
+	SourceARNs     []string
+}
+
+type keyPolicyViaService struct {
+	Actions        []string
+	CallerAccounts []string
+	Principals     []string
+	Services       []string
+	Sid            string
+}
+
+type keyPolicyOptions struct {
+	AccountID                      string
+	AllowGrantsForAWSResources     bool
+	AllowKeyAdministratorsToDelete bool
+	EnableRootAccess               bool
+	KeyAdministrators              []string
+	KeySpec                        string
+	KeyUsage                       string
+	KeyUsers                       []string
+	Partition                      string
+	PolicyID                       string
+	ServicePrincipals              []keyPolicyServicePrincipal
+	ViaServices                    []keyPolicyViaService
+}
+
+// keyAdministratorActions returns the actions granted to key administrators by the default key policy.
+func keyAdministratorActions(allowDelete bool) []string {
+	actions := []string{
+		"kms:Create*",
+		"kms:Describe*",
+		"kms:Enable*",
+		"kms:List*",
+		"kms:Put*",
+		"kms:Update*",
+		"kms:Revoke*",
+		"kms:Disable*",
+		"kms:Get*",
+		"kms:Delete*",
+		"kms:TagResource",
+		"kms:UntagResource",
+	}
+
+	if allowDelete {
+		actions = append(actions, "kms:ScheduleKeyDeletion", "kms:CancelKeyDeletion")
+	}
+
+	return actions
+}
+
+// keyUserActions returns the actions granted to key users by the default key policy for a key of the specified usage and spec.
+func keyUserActions(keyUsage, keySpec string) []string {
+	switch keyUsage {
+	case kms.KeyUsageTypeGenerateVerifyMac:
+		return []string{"kms:DescribeKey", "kms:GenerateMac", "kms:VerifyMac"}
+	case kms.KeyUsageTypeSignVerify:
+		return []string{"kms:DescribeKey", "kms:GetPublicKey", "kms:Sign", "kms:Verify"}
+	}
+
+	if isSymmetricEncryptionKey(keyUsage, keySpec) {
+		return []string{"kms:Encrypt", "kms:Decrypt", "kms:ReEncrypt*", "kms:GenerateDataKey*", "kms:DescribeKey"}
+	}
+
+	return []string{"kms:Encrypt", "kms:Decrypt", "kms:ReEncrypt*", "kms:DescribeKey", "kms:GetPublicKey"}
+}
+
+// keyViaServiceActions returns the actions granted to principals using the key through an AWS service
+// by the key policies of AWS managed keys.
+func keyViaServiceActions(keyUsage, keySpec string) []string {
+	actions := keyUserActions(keyUsage, keySpec)
+
+	if isSymmetricEncryptionKey(keyUsage, keySpec) {
+		actions = append(actions, "kms:CreateGrant")
+	}
+
+	return actions
+}
+
+func isSymmetricEncryptionKey(keyUsage, keySpec string) bool {
+	return keyUsage == kms.KeyUsageTypeEncryptDecrypt && keySpec == kms.KeySpecSymmetricDefault
+}
+
+// expandKeyPolicy composes a key policy from the statements of the default key policy and
+// any additional service statements.
+func expandKeyPolicy(options keyPolicyOptions) (*tfiam.IAMPolicyDoc, error) {
+	doc := &tfiam.IAMPolicyDoc{
+		Id:      options.PolicyID,
+		Version: keyPolicyVersion,
+	}
+
+	if options.EnableRootAccess {
+		doc.Statements = append(doc.Statements, &tfiam.IAMPolicyStatement{
+			Sid:        keyPolicySidRootAccess,
+			Effect:     "Allow",
+			Principals: keyPolicyPrincipals("AWS", []string{fmt.Sprintf("arn:%s:iam::%s:root", options.Partition, options.AccountID)}),
+			Actions:    "kms:*",
+			Resources:  "*",
+		})
+	}
+
+	if len(options.KeyAdministrators) > 0 {
+		doc.Statements = append(doc.Statements, &tfiam.IAMPolicyStatement{
+			Sid:        keyPolicySidAdministrators,
+			Effect:     "Allow",
+			Principals: keyPolicyPrincipals("AWS", options.KeyAdministrators),
+			Actions:    keyAdministratorActions(options.AllowKeyAdministratorsToDelete),
+			Resources:  "*",
+		})
+	}
+
+	if len(options.KeyUsers) > 0 {
+		doc.Statements = append(doc.Statements, &tfiam.IAMPolicyStatement{
+			Sid:        keyPolicySidUsers,
+			Effect:     "Allow",
+			Principals: keyPolicyPrincipals("AWS", options.KeyUsers),
+			Actions:    keyUserActions(options.KeyUsage, options.KeySpec),
+			Resources:  "*",
+		})
+
+		// Grants are only used by AWS services integrated with symmetric encryption keys.
+		if options.AllowGrantsForAWSResources && isSymmetricEncryptionKey(options.KeyUsage, options.KeySpec) {
+			doc.Statements = append(doc.Statements, &tfiam.IAMPolicyStatement{
+				Sid:        keyPolicySidGrants,
+				Effect:     "Allow",
+				Principals: keyPolicyPrincipals("AWS", options.KeyUsers),
+				Actions:    []string{"kms:CreateGrant", "kms:ListGrants", "kms:RevokeGrant"},
+				Resources:  "*",
+				Conditions: tfiam.IAMPolicyStatementConditionSet{
+					{
+						Test:     "Bool",
+						Variable: "kms:GrantIsForAWSResource",
+						Values:   "true",
+					},
+				},
+			})
+		}
+	}
+
+	for _, sp := range options.ServicePrincipals {
+		actions := sp.Actions
+
+		if len(actions) == 0 {
+			actions = keyUserActions(options.KeyUsage, options.KeySpec)
+		}
+
+		stmt := &tfiam.IAMPolicyStatement{
+			Sid:        sp.Sid,
+			Effect:     "Allow",
+			Principals: keyPolicyPrincipals("Service", sp.Identifiers),
+			Actions:    keyPolicyStringList(actions),
+			Resources:  "*",
+		}
+
+		if len(sp.SourceAccounts) > 0 {
+			stmt.Conditions = append(stmt.Conditions, tfiam.IAMPolicyStatementCondition{
+				Test:     "StringEquals",
+				Variable: "aws:SourceAccount",
+				Values:   keyPolicyStringList(sp.SourceAccounts),
+			})
+		}
+
+		if len(sp.SourceARNs) > 0 {
+			stmt.Conditions = append(stmt.Conditions, tfiam.IAMPolicyStatementCondition{
+				Test:     "ArnLike",
+				Variable: "aws:SourceArn",
+				Values:   keyPolicyStringList(sp.SourceARNs),
+			})
+		}
+
+		doc.Statements = append(doc.Statements, stmt)
+	}
+
+	for _, vs := range options.ViaServices {
+		actions := vs.Actions
+
+		if len(actions) == 0 {
+			actions = keyViaServiceActions(options.KeyUsage, options.KeySpec)
+		}
+
+		callerAccounts := vs.CallerAccounts
+
+		if len(callerAccounts) == 0 {
+			callerAccounts = []string{options.AccountID}
+		}
+
+		principals := vs.Principals
+
+		if len(principals) == 0 {
+			principals = []string{"*"}
+		}
+
+		doc.Statements = append(doc.Statements, &tfiam.IAMPolicyStatement{
+			Sid:        vs.Sid,
+			Effect:     "Allow",
+			Principals: keyPolicyPrincipals("AWS", principals),
+			Actions:    keyPolicyStringList(actions),
+			Resources:  "*",
+			Conditions: tfiam.IAMPolicyStatementConditionSet{
+				{
+					Test:     "StringEquals",
+					Variable: "kms:CallerAccount",
+					Values:   keyPolicyStringList(callerAccounts),
+				},
+				{
+					Test:     "StringEquals",
+					Variable: "kms:ViaService",
+					Values:   keyPolicyStringList(vs.Services),
+				},
+			},
+		})
+	}
+
+	sids := make(map[string]struct{})
+
+	for _, stmt := range doc.Statements {
+		if stmt.Sid == "" {
+			continue
+		}
+
+		if _, ok := sids[stmt.Sid]; ok {
+			return nil, fmt.Errorf("duplicate Sid (%s). Remove the Sid or ensure the Sid is unique.", stmt.Sid)
+		}
+
+		sids[stmt.Sid] = struct{}{}
+	}
+
+	return doc, nil
+}
+
+func keyPolicyPrincipals(principalType string, identifiers []string) tfiam.IAMPolicyStatementPrincipalSet {
+	return tfiam.IAMPolicyStatementPrincipalSet{
+		{
+			Type:        principalType,
+			Identifiers: keyPolicyStringList(identifiers),
+		},
+	}
+}
+
+// keyPolicyStringList returns a single value as a string and multiple values as a sorted list,
+// matching the shape of the documents generated by AWS.
+func keyPolicyStringList(values []string) interface{} {
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	list := make([]string, len(values))
+	copy(list, values)
+	sort.Strings(list)
+
+	return list
+}
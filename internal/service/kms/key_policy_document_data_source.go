@@ -0,0 +1,271 @@
+package kms
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceKeyPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKeyPolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"allow_grants_for_aws_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"allow_key_administrators_to_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"enable_root_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_administrators": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"key_spec": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      kms.KeySpecSymmetricDefault,
+				ValidateFunc: validation.StringInSlice(kms.KeySpec_Values(), false),
+			},
+			"key_usage": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      kms.KeyUsageTypeEncryptDecrypt,
+				ValidateFunc: validation.StringInSlice(kms.KeyUsageType_Values(), false),
+			},
+			"key_users": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"service_principal": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"identifiers": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"sid": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source_accounts": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidAccountID,
+							},
+						},
+						"source_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"source_policy_documents": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+			"via_service": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"caller_accounts": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidAccountID,
+							},
+						},
+						"principals": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"services": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"sid": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeyPolicyDocumentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*conns.AWSClient)
+
+	accountID := client.AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	options := keyPolicyOptions{
+		AccountID:                      accountID,
+		AllowGrantsForAWSResources:     d.Get("allow_grants_for_aws_resources").(bool),
+		AllowKeyAdministratorsToDelete: d.Get("allow_key_administrators_to_delete").(bool),
+		EnableRootAccess:               d.Get("enable_root_access").(bool),
+		KeyAdministrators:              aws.StringValueSlice(flex.ExpandStringSet(d.Get("key_administrators").(*schema.Set))),
+		KeySpec:                        d.Get("key_spec").(string),
+		KeyUsage:                       d.Get("key_usage").(string),
+		KeyUsers:                       aws.StringValueSlice(flex.ExpandStringSet(d.Get("key_users").(*schema.Set))),
+		Partition:                      client.Partition,
+		PolicyID:                       d.Get("policy_id").(string),
+		ServicePrincipals:              expandKeyPolicyServicePrincipals(d.Get("service_principal").([]interface{})),
+		ViaServices:                    expandKeyPolicyViaServices(d.Get("via_service").([]interface{})),
+	}
+
+	doc, err := expandKeyPolicy(options)
+
+	if err != nil {
+		return err
+	}
+
+	mergedDoc := &tfiam.IAMPolicyDoc{}
+
+	// Statements of the source documents are overridden by generated statements with the same Sid.
+	if v, ok := d.GetOk("source_policy_documents"); ok && len(v.([]interface{})) > 0 {
+		for _, sourceJSON := range v.([]interface{}) {
+			if sourceJSON == nil {
+				continue
+			}
+
+			sourceDoc := &tfiam.IAMPolicyDoc{}
+			if err := json.Unmarshal([]byte(sourceJSON.(string)), sourceDoc); err != nil {
+				return fmt.Errorf("error reading source policy document: %w", err)
+			}
+
+			mergedDoc.Merge(sourceDoc)
+		}
+	}
+
+	mergedDoc.Merge(doc)
+
+	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("error marshaling KMS key policy document: %w", err)
+	}
+
+	jsonString := string(jsonDoc)
+
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	d.Set("account_id", accountID)
+	d.Set("json", jsonString)
+
+	return nil
+}
+
+func expandKeyPolicyServicePrincipals(tfList []interface{}) []keyPolicyServicePrincipal {
+	var apiObjects []keyPolicyServicePrincipal
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, keyPolicyServicePrincipal{
+			Actions:        aws.StringValueSlice(flex.ExpandStringSet(tfMap["actions"].(*schema.Set))),
+			Identifiers:    aws.StringValueSlice(flex.ExpandStringSet(tfMap["identifiers"].(*schema.Set))),
+			Sid:            tfMap["sid"].(string),
+			SourceAccounts: aws.StringValueSlice(flex.ExpandStringSet(tfMap["source_accounts"].(*schema.Set))),
+			SourceARNs:     aws.StringValueSlice(flex.ExpandStringSet(tfMap["source_arns"].(*schema.Set))),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandKeyPolicyViaServices(tfList []interface{}) []keyPolicyViaService {
+	var apiObjects []keyPolicyViaService
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, keyPolicyViaService{
+			Actions:        aws.StringValueSlice(flex.ExpandStringSet(tfMap["actions"].(*schema.Set))),
+			CallerAccounts: aws.StringValueSlice(flex.ExpandStringSet(tfMap["caller_accounts"].(*schema.Set))),
+			Principals:     aws.StringValueSlice(flex.ExpandStringSet(tfMap["principals"].(*schema.Set))),
+			Services:       aws.StringValueSlice(flex.ExpandStringSet(tfMap["services"].(*schema.Set))),
+			Sid:            tfMap["sid"].(string),
+		})
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,112 @@
+package kms_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKMSKeyPolicyDocumentDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_kms_key_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, "account_id"),
+					resource.TestMatchResourceAttr(dataSourceName, "json", regexp.MustCompile(`"Enable IAM User Permissions"`)),
+					resource.TestMatchResourceAttr(dataSourceName, "json", regexp.MustCompile(`"kms:GrantIsForAWSResource": "true"`)),
+					resource.TestMatchResourceAttr(dataSourceName, "json", regexp.MustCompile(`"kms:ViaService": "ec2.`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSKeyPolicyDocumentDataSource_key(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPolicyDocumentDataSourceConfig_key(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`Allow CloudWatch Logs`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSKeyPolicyDocumentDataSource_duplicateSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKeyPolicyDocumentDataSourceConfig_duplicateSid,
+				ExpectError: regexp.MustCompile(`duplicate Sid`),
+			},
+		},
+	})
+}
+
+const testAccKeyPolicyDocumentDataSourceConfig_basic = `
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_kms_key_policy_document" "test" {
+  key_administrators = ["arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/admin"]
+  key_users          = ["arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/user"]
+
+  via_service {
+    services = ["ec2.${data.aws_region.current.name}.amazonaws.com"]
+  }
+}
+`
+
+func testAccKeyPolicyDocumentDataSourceConfig_key(rName string) string {
+	return acctest.ConfigCompose(`
+data "aws_region" "current" {}
+
+data "aws_kms_key_policy_document" "test" {
+  service_principal {
+    identifiers = ["logs.${data.aws_region.current.name}.amazonaws.com"]
+    sid         = "Allow CloudWatch Logs"
+  }
+}
+`, fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  policy                  = data.aws_kms_key_policy_document.test.json
+}
+`, rName))
+}
+
+const testAccKeyPolicyDocumentDataSourceConfig_duplicateSid = `
+data "aws_kms_key_policy_document" "test" {
+  service_principal {
+    identifiers = ["logs.amazonaws.com"]
+    sid         = "Enable IAM User Permissions"
+  }
+}
+`
@@ -0,0 +1,305 @@
+package kms
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
+)
+
+// Expected documents are the default key policies described in
+// https://docs.aws.amazon.com/kms/latest/developerguide/key-policy-default.html.
+
+const testKeyPolicyDefault = `{
+  "Version": "2012-10-17",
+  "Id": "key-default-1",
+  "Statement": [
+    {
+      "Sid": "Enable IAM User Permissions",
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::111122223333:root"},
+      "Action": "kms:*",
+      "Resource": "*"
+    }
+  ]
+}`
+
+const testKeyPolicyConsoleSymmetric = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Enable IAM User Permissions",
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::111122223333:root"},
+      "Action": "kms:*",
+      "Resource": "*"
+    },
+    {
+      "Sid": "Allow access for Key Administrators",
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::111122223333:role/KMSKeyAdmins"},
+      "Action": [
+        "kms:Create*",
+        "kms:Describe*",
+        "kms:Enable*",
+        "kms:List*",
+        "kms:Put*",
+        "kms:Update*",
+        "kms:Revoke*",
+        "kms:Disable*",
+        "kms:Get*",
+        "kms:Delete*",
+        "kms:TagResource",
+        "kms:UntagResource",
+        "kms:ScheduleKeyDeletion",
+        "kms:CancelKeyDeletion"
+      ],
+      "Resource": "*"
+    },
+    {
+      "Sid": "Allow use of the key",
+      "Effect": "Allow",
+      "Principal": {"AWS": [
+        "arn:aws:iam::111122223333:role/ExampleRole",
+        "arn:aws:iam::444455556666:root"
+      ]},
+      "Action": [
+        "kms:Encrypt",
+        "kms:Decrypt",
+        "kms:ReEncrypt*",
+        "kms:GenerateDataKey*",
+        "kms:DescribeKey"
+      ],
+      "Resource": "*"
+    },
+    {
+      "Sid": "Allow attachment of persistent resources",
+      "Effect": "Allow",
+      "Principal": {"AWS": [
+        "arn:aws:iam::111122223333:role/ExampleRole",
+        "arn:aws:iam::444455556666:root"
+      ]},
+      "Action": [
+        "kms:CreateGrant",
+        "kms:ListGrants",
+        "kms:RevokeGrant"
+      ],
+      "Resource": "*",
+      "Condition": {"Bool": {"kms:GrantIsForAWSResource": "true"}}
+    }
+  ]
+}`
+
+const testKeyPolicyConsoleSignVerify = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Enable IAM User Permissions",
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::111122223333:root"},
+      "Action": "kms:*",
+      "Resource": "*"
+    },
+    {
+      "Sid": "Allow access for Key Administrators",
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::111122223333:role/KMSKeyAdmins"},
+      "Action": [
+        "kms:Create*",
+        "kms:Describe*",
+        "kms:Enable*",
+        "kms:List*",
+        "kms:Put*",
+        "kms:Update*",
+        "kms:Revoke*",
+        "kms:Disable*",
+        "kms:Get*",
+        "kms:Delete*",
+        "kms:TagResource",
+        "kms:UntagResource"
+      ],
+      "Resource": "*"
+    },
+    {
+      "Sid": "Allow use of the key",
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::111122223333:role/ExampleRole"},
+      "Action": [
+        "kms:DescribeKey",
+        "kms:GetPublicKey",
+        "kms:Sign",
+        "kms:Verify"
+      ],
+      "Resource": "*"
+    }
+  ]
+}`
+
+const testKeyPolicyServices = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Allow CloudWatch Logs",
+      "Effect": "Allow",
+      "Principal": {"Service": "logs.us-west-2.amazonaws.com"},
+      "Action": [
+        "kms:Decrypt",
+        "kms:Encrypt",
+        "kms:GenerateDataKey*"
+      ],
+      "Resource": "*",
+      "Condition": {
+        "ArnLike": {"aws:SourceArn": "arn:aws:logs:us-west-2:111122223333:*"},
+        "StringEquals": {"aws:SourceAccount": "111122223333"}
+      }
+    },
+    {
+      "Sid": "Allow access through EBS for all principals in the account that are authorized to use EBS",
+      "Effect": "Allow",
+      "Principal": {"AWS": "*"},
+      "Action": [
+        "kms:Encrypt",
+        "kms:Decrypt",
+        "kms:ReEncrypt*",
+        "kms:GenerateDataKey*",
+        "kms:CreateGrant",
+        "kms:DescribeKey"
+      ],
+      "Resource": "*",
+      "Condition": {
+        "StringEquals": {
+          "kms:CallerAccount": "111122223333",
+          "kms:ViaService": "ec2.us-west-2.amazonaws.com"
+        }
+      }
+    }
+  ]
+}`
+
+func TestExpandKeyPolicy(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Options  keyPolicyOptions
+		Expected string
+	}{
+		{
+			Name: "default",
+			Options: keyPolicyOptions{
+				AccountID:        "111122223333",
+				EnableRootAccess: true,
+				KeySpec:          kms.KeySpecSymmetricDefault,
+				KeyUsage:         kms.KeyUsageTypeEncryptDecrypt,
+				Partition:        "aws",
+				PolicyID:         "key-default-1",
+			},
+			Expected: testKeyPolicyDefault,
+		},
+		{
+			Name: "console symmetric",
+			Options: keyPolicyOptions{
+				AccountID:                      "111122223333",
+				AllowGrantsForAWSResources:     true,
+				AllowKeyAdministratorsToDelete: true,
+				EnableRootAccess:               true,
+				KeyAdministrators:              []string{"arn:aws:iam::111122223333:role/KMSKeyAdmins"},
+				KeySpec:                        kms.KeySpecSymmetricDefault,
+				KeyUsage:                       kms.KeyUsageTypeEncryptDecrypt,
+				KeyUsers:                       []string{"arn:aws:iam::111122223333:role/ExampleRole", "arn:aws:iam::444455556666:root"},
+				Partition:                      "aws",
+			},
+			Expected: testKeyPolicyConsoleSymmetric,
+		},
+		{
+			Name: "console sign verify",
+			Options: keyPolicyOptions{
+				AccountID:                  "111122223333",
+				AllowGrantsForAWSResources: true,
+				EnableRootAccess:           true,
+				KeyAdministrators:          []string{"arn:aws:iam::111122223333:role/KMSKeyAdmins"},
+				KeySpec:                    kms.KeySpecRsa2048,
+				KeyUsage:                   kms.KeyUsageTypeSignVerify,
+				KeyUsers:                   []string{"arn:aws:iam::111122223333:role/ExampleRole"},
+				Partition:                  "aws",
+			},
+			Expected: testKeyPolicyConsoleSignVerify,
+		},
+		{
+			Name: "services",
+			Options: keyPolicyOptions{
+				AccountID: "111122223333",
+				KeySpec:   kms.KeySpecSymmetricDefault,
+				KeyUsage:  kms.KeyUsageTypeEncryptDecrypt,
+				Partition: "aws",
+				ServicePrincipals: []keyPolicyServicePrincipal{
+					{
+						Actions:        []string{"kms:Encrypt", "kms:Decrypt", "kms:GenerateDataKey*"},
+						Identifiers:    []string{"logs.us-west-2.amazonaws.com"},
+						Sid:            "Allow CloudWatch Logs",
+						SourceAccounts: []string{"111122223333"},
+						SourceARNs:     []string{"arn:aws:logs:us-west-2:111122223333:*"},
+					},
+				},
+				ViaServices: []keyPolicyViaService{
+					{
+						Services: []string{"ec2.us-west-2.amazonaws.com"},
+						Sid:      "Allow access through EBS for all principals in the account that are authorized to use EBS",
+					},
+				},
+			},
+			Expected: testKeyPolicyServices,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc, err := expandKeyPolicy(testCase.Options)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := json.Marshal(doc)
+
+			if err != nil {
+				t.Fatalf("error marshaling policy: %s", err)
+			}
+
+			equivalent, err := awspolicy.PoliciesAreEquivalent(string(got), testCase.Expected)
+
+			if err != nil {
+				t.Fatalf("error comparing policies: %s", err)
+			}
+
+			if !equivalent {
+				t.Errorf("policy not equivalent\ngot:\n%s\nwant:\n%s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestExpandKeyPolicyDuplicateSid(t *testing.T) {
+	options := keyPolicyOptions{
+		AccountID:        "111122223333",
+		EnableRootAccess: true,
+		KeySpec:          kms.KeySpecSymmetricDefault,
+		KeyUsage:         kms.KeyUsageTypeEncryptDecrypt,
+		Partition:        "aws",
+		ServicePrincipals: []keyPolicyServicePrincipal{
+			{
+				Identifiers: []string{"logs.us-west-2.amazonaws.com"},
+				Sid:         keyPolicySidRootAccess,
+			},
+		},
+	}
+
+	_, err := expandKeyPolicy(options)
+
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if expected := "duplicate Sid (Enable IAM User Permissions). Remove the Sid or ensure the Sid is unique."; err.Error() != expected {
+		t.Errorf("expected error %q, got %q", expected, err)
+	}
+}
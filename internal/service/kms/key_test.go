@@ -2,6 +2,7 @@ package kms_test
 
 import (
 	"fmt"
+	"os"
 	"regexp"
 	"testing"
 
@@ -121,6 +122,40 @@ func TestAccKMSKey_asymmetricKey(t *testing.T) {
 	})
 }
 
+func TestAccKMSKey_customKeyStore(t *testing.T) {
+	// A custom key store cannot be deleted while it contains keys pending deletion, so an existing connected store is used.
+	customKeyStoreID := os.Getenv("KMS_CUSTOM_KEY_STORE_ID")
+	if customKeyStoreID == "" {
+		t.Skip("Environment variable KMS_CUSTOM_KEY_STORE_ID is not set")
+	}
+
+	var key kms.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKey_customKeyStore(rName, customKeyStoreID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_id", customKeyStoreID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check"},
+			},
+		},
+	})
+}
+
 func TestAccKMSKey_Policy_basic(t *testing.T) {
 	var key kms.KeyMetadata
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -529,6 +564,16 @@ resource "aws_kms_key" "test" {
 `, rName)
 }
 
+func testAccKey_customKeyStore(rName, customKeyStoreID string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  custom_key_store_id     = %[2]q
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+`, rName, customKeyStoreID)
+}
+
 func testAccKey_multiRegion(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -1,6 +1,7 @@
 package kms
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,6 +15,9 @@ import (
 )
 
 const (
+	CustomKeyStoreConnectedTimeout    = 20 * time.Minute
+	CustomKeyStoreDisconnectedTimeout = 10 * time.Minute
+
 	// Maximum amount of time to wait for StatusKeyState to return PendingDeletion
 	KeyStatePendingDeletionTimeout = 20 * time.Minute
 
@@ -38,6 +42,45 @@ func WaitIAMPropagation(f func() (interface{}, error)) (interface{}, error) {
 	return tfresource.RetryWhenAWSErrCodeEquals(tfiam.PropagationTimeout, f, kms.ErrCodeMalformedPolicyDocumentException)
 }
 
+func WaitCustomKeyStoreConnected(conn *kms.KMS, id string, timeout time.Duration) (*kms.CustomKeyStoresListEntry, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kms.ConnectionStateTypeConnecting, kms.ConnectionStateTypeDisconnected},
+		Target:  []string{kms.ConnectionStateTypeConnected},
+		Refresh: StatusCustomKeyStoreConnectionState(conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kms.CustomKeyStoresListEntry); ok {
+		if state := aws.StringValue(output.ConnectionState); state == kms.ConnectionStateTypeFailed {
+			tfresource.SetLastError(err, fmt.Errorf("connection error code: %s", aws.StringValue(output.ConnectionErrorCode)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCustomKeyStoreDisconnected(conn *kms.KMS, id string, timeout time.Duration) (*kms.CustomKeyStoresListEntry, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kms.ConnectionStateTypeDisconnecting, kms.ConnectionStateTypeConnected, kms.ConnectionStateTypeFailed},
+		Target:  []string{kms.ConnectionStateTypeDisconnected},
+		Refresh: StatusCustomKeyStoreConnectionState(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kms.CustomKeyStoresListEntry); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitKeyDeleted(conn *kms.KMS, id string) (*kms.KeyMetadata, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{kms.KeyStateDisabled, kms.KeyStateEnabled},
@@ -31,6 +31,7 @@ const (
 	InstanceStatusIncompatibleParameters        = "incompatible-parameters"
 	InstanceStatusIncompatibleRestore           = "incompatible-restore"
 	InstanceStatusModifying                     = "modifying"
+	InstanceStatusRenaming                      = "renaming"
 	InstanceStatusStarting                      = "starting"
 	InstanceStatusStopping                      = "stopping"
 	InstanceStatusStorageFull                   = "storage-full"
@@ -79,3 +80,47 @@ func ExportableLogType_Values() []string {
 		ExportableLogTypeUpgrade,
 	}
 }
+
+const (
+	ReservedInstanceStateActive         = "active"
+	ReservedInstanceStatePaymentPending = "payment-pending"
+	ReservedInstanceStateRetired        = "retired"
+)
+
+const (
+	ReservedInstanceOfferingTypeAllUpfront     = "All Upfront"
+	ReservedInstanceOfferingTypeNoUpfront      = "No Upfront"
+	ReservedInstanceOfferingTypePartialUpfront = "Partial Upfront"
+)
+
+func ReservedInstanceOfferingType_Values() []string {
+	return []string{
+		ReservedInstanceOfferingTypeAllUpfront,
+		ReservedInstanceOfferingTypeNoUpfront,
+		ReservedInstanceOfferingTypePartialUpfront,
+	}
+}
+
+const (
+	NetworkTypeDual = "DUAL"
+	NetworkTypeIPv4 = "IPV4"
+)
+
+func NetworkType_Values() []string {
+	return []string{
+		NetworkTypeDual,
+		NetworkTypeIPv4,
+	}
+}
+
+const (
+	UpgradeStrategyInPlace          = "in_place"
+	UpgradeStrategyReplicaPromotion = "replica_promotion"
+)
+
+func UpgradeStrategy_Values() []string {
+	return []string{
+		UpgradeStrategyInPlace,
+		UpgradeStrategyReplicaPromotion,
+	}
+}
@@ -2,8 +2,11 @@ package rds
 
 import (
 	"log"
+	"sort"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -187,6 +190,40 @@ func FindDBInstanceByID(conn *rds.RDS, id string) (*rds.DBInstance, error) {
 	return dbInstance, nil
 }
 
+// findDBInstanceReplicaLag returns the most recent ReplicaLag metric of a read replica.
+func findDBInstanceReplicaLag(conn *cloudwatch.CloudWatch, id string) (time.Duration, error) {
+	now := time.Now()
+	input := &cloudwatch.GetMetricStatisticsInput{
+		Dimensions: []*cloudwatch.Dimension{{
+			Name:  aws.String("DBInstanceIdentifier"),
+			Value: aws.String(id),
+		}},
+		EndTime:    aws.Time(now),
+		MetricName: aws.String("ReplicaLag"),
+		Namespace:  aws.String("AWS/RDS"),
+		Period:     aws.Int64(60),
+		StartTime:  aws.Time(now.Add(-5 * time.Minute)),
+		Statistics: aws.StringSlice([]string{cloudwatch.StatisticMaximum}),
+	}
+
+	output, err := conn.GetMetricStatistics(input)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if output == nil || len(output.Datapoints) == 0 {
+		return 0, tfresource.NewEmptyResultError(input)
+	}
+
+	datapoints := output.Datapoints
+	sort.Slice(datapoints, func(i, j int) bool {
+		return aws.TimeValue(datapoints[i].Timestamp).After(aws.TimeValue(datapoints[j].Timestamp))
+	})
+
+	return time.Duration(aws.Float64Value(datapoints[0].Maximum) * float64(time.Second)), nil
+}
+
 func FindDBProxyByName(conn *rds.RDS, name string) (*rds.DBProxy, error) {
 	input := &rds.DescribeDBProxiesInput{
 		DBProxyName: aws.String(name),
@@ -315,3 +352,32 @@ func findDBInstanceAutomatedBackups(conn *rds.RDS, input *rds.DescribeDBInstance
 
 	return output, nil
 }
+
+func FindReservedDBInstanceByID(conn *rds.RDS, id string) (*rds.ReservedDBInstance, error) {
+	input := &rds.DescribeReservedDBInstancesInput{
+		ReservedDBInstanceId: aws.String(id),
+	}
+
+	output, err := conn.DescribeReservedDBInstances(input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeReservedDBInstanceNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ReservedDBInstances) == 0 || output.ReservedDBInstances[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ReservedDBInstances); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ReservedDBInstances[0], nil
+}
@@ -255,3 +255,24 @@ func FlattenParameters(list []*rds.Parameter) []map[string]interface{} {
 
 	return result
 }
+
+func flattenRecurringCharges(apiObjects []*rds.RecurringCharge) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"recurring_charge_amount":    aws.Float64Value(apiObject.RecurringChargeAmount),
+			"recurring_charge_frequency": aws.StringValue(apiObject.RecurringChargeFrequency),
+		})
+	}
+
+	return tfList
+}
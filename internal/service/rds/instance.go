@@ -102,6 +102,12 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"automation_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(rds.AutomationMode_Values(), false),
+			},
 			"availability_zone": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -135,6 +141,12 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"custom_iam_instance_profile": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^AWSRDSCustom.*$`), "must begin with AWSRDSCustom"),
+			},
 			"customer_owned_ip_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -316,6 +328,12 @@ func ResourceInstance() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"network_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(NetworkType_Values(), false),
+			},
 			"option_group_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -418,6 +436,11 @@ func ResourceInstance() *schema.Resource {
 					},
 				},
 			},
+			"resume_full_automation_mode_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(60, 1440),
+			},
 			"s3_import": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -496,6 +519,36 @@ func ResourceInstance() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"upgrade_max_replica_lag": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"upgrade_progress": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"replica_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"retired_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"step": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"upgrade_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(UpgradeStrategy_Values(), false),
+			},
 			"username": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -562,6 +615,10 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			opts.AvailabilityZone = aws.String(attr.(string))
 		}
 
+		if attr, ok := d.GetOk("custom_iam_instance_profile"); ok {
+			opts.CustomIamInstanceProfile = aws.String(attr.(string))
+		}
+
 		if attr, ok := d.GetOk("db_subnet_group_name"); ok {
 			opts.DBSubnetGroupName = aws.String(attr.(string))
 		}
@@ -597,6 +654,10 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			opts.MultiAZ = aws.Bool(attr.(bool))
 		}
 
+		if attr, ok := d.GetOk("network_type"); ok {
+			opts.NetworkType = aws.String(attr.(string))
+		}
+
 		if attr, ok := d.GetOk("option_group_name"); ok {
 			opts.OptionGroupName = aws.String(attr.(string))
 		}
@@ -758,6 +819,10 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			opts.MultiAZ = aws.Bool(attr.(bool))
 		}
 
+		if attr, ok := d.GetOk("network_type"); ok {
+			opts.NetworkType = aws.String(attr.(string))
+		}
+
 		if _, ok := d.GetOk("character_set_name"); ok {
 			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "character_set_name" doesn't work with with restores"`, dbName)
 		}
@@ -951,6 +1016,10 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			requiresModifyDbInstance = true
 		}
 
+		if attr, ok := d.GetOk("custom_iam_instance_profile"); ok {
+			opts.CustomIamInstanceProfile = aws.String(attr.(string))
+		}
+
 		if attr, ok := d.GetOk("db_subnet_group_name"); ok {
 			opts.DBSubnetGroupName = aws.String(attr.(string))
 		}
@@ -1024,6 +1093,10 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 
+		if attr, ok := d.GetOk("network_type"); ok {
+			opts.NetworkType = aws.String(attr.(string))
+		}
+
 		if attr, ok := d.GetOk("option_group_name"); ok {
 			opts.OptionGroupName = aws.String(attr.(string))
 		}
@@ -1111,6 +1184,10 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 				input.AvailabilityZone = aws.String(v.(string))
 			}
 
+			if v, ok := d.GetOk("custom_iam_instance_profile"); ok {
+				input.CustomIamInstanceProfile = aws.String(v.(string))
+			}
+
 			if v, ok := d.GetOk("db_name"); ok {
 				input.DBName = aws.String(v.(string))
 			}
@@ -1155,6 +1232,10 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 				input.DBName = aws.String(v.(string))
 			}
 
+			if v, ok := d.GetOk("network_type"); ok {
+				input.NetworkType = aws.String(v.(string))
+			}
+
 			if v, ok := d.GetOk("option_group_name"); ok {
 				input.OptionGroupName = aws.String(v.(string))
 			}
@@ -1238,6 +1319,14 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 
 		}
 
+		if attr, ok := d.GetOk("custom_iam_instance_profile"); ok {
+			opts.CustomIamInstanceProfile = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("network_type"); ok {
+			opts.NetworkType = aws.String(attr.(string))
+		}
+
 		if attr, ok := d.GetOk("character_set_name"); ok {
 			opts.CharacterSetName = aws.String(attr.(string))
 		}
@@ -1426,6 +1515,13 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// RDS Custom automation can only be paused once the DB instance is available.
+	if v, ok := d.GetOk("automation_mode"); ok && v.(string) != rds.AutomationModeFull {
+		if err := updateInstanceAutomationMode(conn, d.Id(), v.(string), d.Get("resume_full_automation_mode_minutes").(int), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
 	return resourceInstanceRead(d, meta)
 }
 
@@ -1436,6 +1532,12 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 
 	v, err := FindDBInstanceByID(conn, d.Id())
 
+	// The DB instance has been renamed by an upgrade that failed before the upgraded DB instance was swapped in.
+	if !d.IsNewResource() && tfresource.NotFound(err) && instanceUpgradeStep(d) == instanceUpgradeStateRenameReplica {
+		log.Printf("[WARN] DB Instance (%s) not found while being upgraded, skipping refresh", d.Id())
+		return nil
+	}
+
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] DB Instance (%s) not found, removing from state", d.Id())
 		d.SetId("")
@@ -1565,6 +1667,10 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("customer_owned_ip_enabled", v.CustomerOwnedIpEnabled)
 
+	d.Set("automation_mode", v.AutomationMode)
+	d.Set("custom_iam_instance_profile", v.CustomIamInstanceProfile)
+	d.Set("network_type", v.NetworkType)
+
 	return nil
 }
 
@@ -1619,6 +1725,31 @@ func waitUntilDBInstanceAvailableAfterUpdate(id string, conn *rds.RDS, timeout t
 	return err
 }
 
+// updateInstanceAutomationMode pauses or resumes RDS Custom automation.
+// The automation mode cannot be modified together with other DB instance settings.
+func updateInstanceAutomationMode(conn *rds.RDS, id, mode string, resumeMinutes int, timeout time.Duration) error {
+	input := &rds.ModifyDBInstanceInput{
+		ApplyImmediately:     aws.Bool(true),
+		AutomationMode:       aws.String(mode),
+		DBInstanceIdentifier: aws.String(id),
+	}
+
+	if mode == rds.AutomationModeAllPaused && resumeMinutes > 0 {
+		input.ResumeFullAutomationModeMinutes = aws.Int64(int64(resumeMinutes))
+	}
+
+	log.Printf("[DEBUG] Updating DB Instance (%s) automation mode: %s", id, input)
+	if _, err := conn.ModifyDBInstance(input); err != nil {
+		return fmt.Errorf("error updating DB Instance (%s) automation mode: %w", id, err)
+	}
+
+	if err := waitUntilDBInstanceAvailableAfterUpdate(id, conn, timeout); err != nil {
+		return fmt.Errorf("error waiting for DB Instance (%s) to be available: %w", id, err)
+	}
+
+	return nil
+}
+
 func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).RDSConn
 
@@ -1631,6 +1762,24 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		log.Println("[INFO] Only settings updating, instance changes will be applied in next maintenance window")
 	}
 
+	// Engine upgrades by replica promotion swap in a new DB instance before any other modifications.
+	replicaPromotionUpgrade := d.HasChange("engine_version") && d.Get("upgrade_strategy").(string) == UpgradeStrategyReplicaPromotion
+	if replicaPromotionUpgrade {
+		if d.Get("replicate_source_db").(string) != "" {
+			return fmt.Errorf("error upgrading DB Instance (%s): upgrade_strategy %q is not supported for read replicas", d.Id(), UpgradeStrategyReplicaPromotion)
+		}
+
+		// Only the upgrade progress is saved if the upgrade fails.
+		d.Partial(true)
+
+		log.Printf("[INFO] Upgrading DB Instance (%s) by replica promotion", d.Id())
+		if err := newInstanceReplicaPromotionUpgrade(d, meta).run(); err != nil {
+			return fmt.Errorf("error upgrading DB Instance (%s): %w", d.Id(), err)
+		}
+
+		d.Partial(false)
+	}
+
 	requestUpdate := false
 	if d.HasChanges("allocated_storage", "iops") {
 		req.Iops = aws.Int64(int64(d.Get("iops").(int)))
@@ -1666,11 +1815,11 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		req.DBInstanceClass = aws.String(d.Get("instance_class").(string))
 		requestUpdate = true
 	}
-	if d.HasChange("parameter_group_name") {
+	if d.HasChange("parameter_group_name") && !replicaPromotionUpgrade {
 		req.DBParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
 		requestUpdate = true
 	}
-	if d.HasChange("engine_version") {
+	if d.HasChange("engine_version") && !replicaPromotionUpgrade {
 		req.EngineVersion = aws.String(d.Get("engine_version").(string))
 		req.AllowMajorVersionUpgrade = aws.Bool(d.Get("allow_major_version_upgrade").(bool))
 		requestUpdate = true
@@ -1809,6 +1958,11 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		requestUpdate = true
 	}
 
+	if d.HasChange("network_type") {
+		req.NetworkType = aws.String(d.Get("network_type").(string))
+		requestUpdate = true
+	}
+
 	log.Printf("[DEBUG] Send DB Instance Modification request: %t", requestUpdate)
 	if requestUpdate {
 		log.Printf("[DEBUG] DB Instance Modification request: %s", req)
@@ -1848,6 +2002,15 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// separate request to pause or resume RDS Custom automation
+	if d.HasChanges("automation_mode", "resume_full_automation_mode_minutes") {
+		if v, ok := d.GetOk("automation_mode"); ok {
+			if err := updateInstanceAutomationMode(conn, d.Id(), v.(string), d.Get("resume_full_automation_mode_minutes").(int), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
 	// separate request to promote a database
 	if d.HasChange("replicate_source_db") {
 		if d.Get("replicate_source_db").(string) == "" {
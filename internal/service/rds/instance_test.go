@@ -3786,6 +3786,15 @@ func testAccCheckInstanceNotRecreated(instance1, instance2 *rds.DBInstance) reso
 	}
 }
 
+func testAccCheckInstanceReplaced(instance1, instance2 *rds.DBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(instance1.DbiResourceId) == aws.StringValue(instance2.DbiResourceId) {
+			return fmt.Errorf("database instance was not replaced: %s", aws.StringValue(instance1.DbiResourceId))
+		}
+		return nil
+	}
+}
+
 func testAccCheckInstanceExists(n string, v *rds.DBInstance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -4515,6 +4524,150 @@ func TestAccRDSInstance_license(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_networkType(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbInstance rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_networkType(rName, "IPV4"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "network_type", "IPV4"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"final_snapshot_identifier",
+					"password",
+					"skip_final_snapshot",
+					"delete_automated_backups",
+				},
+			},
+			{
+				Config: testAccInstanceConfig_networkType(rName, "DUAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "network_type", "DUAL"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_customIAMInstanceProfile(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	key := "RDS_CUSTOM_ORACLE_ENGINE_VERSION"
+	engineVersion := os.Getenv(key)
+	if engineVersion == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var dbInstance rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+	instanceProfileResourceName := "aws_iam_instance_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_customIAMInstanceProfile(rName, engineVersion, "full"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "automation_mode", "full"),
+					resource.TestCheckResourceAttrPair(resourceName, "custom_iam_instance_profile", instanceProfileResourceName, "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"final_snapshot_identifier",
+					"password",
+					"resume_full_automation_mode_minutes",
+					"skip_final_snapshot",
+					"delete_automated_backups",
+				},
+			},
+			{
+				Config: testAccInstanceConfig_customIAMInstanceProfile(rName, engineVersion, "all-paused"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "automation_mode", "all-paused"),
+					resource.TestCheckResourceAttr(resourceName, "resume_full_automation_mode_minutes", "60"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_customIAMInstanceProfile(rName, engineVersion, "full"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "automation_mode", "full"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_UpgradeStrategy_replicaPromotion(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbInstance1, dbInstance2 rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_UpgradeStrategy_replicaPromotion(rName, "5.7"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance1),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", "5.7"),
+					resource.TestCheckResourceAttr(resourceName, "upgrade_strategy", "replica_promotion"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_UpgradeStrategy_replicaPromotion(rName, "8.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance2),
+					testAccCheckInstanceReplaced(&dbInstance1, &dbInstance2),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", "8.0"),
+					resource.TestCheckResourceAttr(resourceName, "identifier", rName),
+					resource.TestCheckResourceAttr(resourceName, "parameter_group_name", "default.mysql8.0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccInstanceConfig_orderableClass(engine, license, storage, classes string) string {
 	return fmt.Sprintf(`
 data "aws_rds_engine_version" "default" {
@@ -9327,3 +9480,163 @@ resource "aws_db_instance" "test" {
 }
 `, rName))
 }
+
+func testAccInstanceConfig_networkType(rName, networkType string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassMySQL(),
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.0.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, count.index)
+  ipv6_cidr_block   = cidrsubnet(aws_vpc.test.ipv6_cidr_block, 8, count.index)
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_db_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = aws_subnet.test[*].id
+}
+
+resource "aws_db_instance" "test" {
+  allocated_storage    = 5
+  apply_immediately    = true
+  db_subnet_group_name = aws_db_subnet_group.test.name
+  engine               = data.aws_rds_orderable_db_instance.test.engine
+  engine_version       = data.aws_rds_orderable_db_instance.test.engine_version
+  identifier           = %[1]q
+  instance_class       = data.aws_rds_orderable_db_instance.test.instance_class
+  network_type         = %[2]q
+  password             = "avoid-plaintext-passwords"
+  username             = "tfacctest"
+  skip_final_snapshot  = true
+}
+`, rName, networkType))
+}
+
+func testAccInstanceConfig_customIAMInstanceProfile(rName, engineVersion, automationMode string) string {
+	var resumeMinutes string
+	if automationMode == rds.AutomationModeAllPaused {
+		resumeMinutes = "resume_full_automation_mode_minutes = 60"
+	}
+
+	return acctest.ConfigCompose(
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "ec2.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonSSMManagedInstanceCore"
+}
+
+resource "aws_iam_instance_profile" "test" {
+  name = "AWSRDSCustom-%[1]s"
+  role = aws_iam_role.test.name
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = "10.0.${count.index}.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_db_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = aws_subnet.test[*].id
+}
+
+resource "aws_db_instance" "test" {
+  allocated_storage           = 50
+  apply_immediately           = true
+  automation_mode             = %[3]q
+  backup_retention_period     = 7
+  custom_iam_instance_profile = aws_iam_instance_profile.test.name
+  db_subnet_group_name        = aws_db_subnet_group.test.name
+  engine                      = "custom-oracle-ee"
+  engine_version              = %[2]q
+  identifier                  = %[1]q
+  instance_class              = "db.m5.large"
+  kms_key_id                  = aws_kms_key.test.arn
+  license_model               = "bring-your-own-license"
+  password                    = "avoid-plaintext-passwords"
+  username                    = "tfacctest"
+  skip_final_snapshot         = true
+  storage_encrypted           = true
+
+  %[4]s
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, engineVersion, automationMode, resumeMinutes))
+}
+
+func testAccInstanceConfig_UpgradeStrategy_replicaPromotion(rName, engineVersion string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassMySQL(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  allocated_storage           = 10
+  allow_major_version_upgrade = true
+  apply_immediately           = true
+  backup_retention_period     = 1
+  engine                      = "mysql"
+  engine_version              = %[2]q
+  identifier                  = %[1]q
+  instance_class              = data.aws_rds_orderable_db_instance.test.instance_class
+  parameter_group_name        = "default.mysql%[2]s"
+  password                    = "avoid-plaintext-passwords"
+  skip_final_snapshot         = true
+  upgrade_max_replica_lag     = 30
+  upgrade_strategy            = "replica_promotion"
+  username                    = "tfacctest"
+}
+`, rName, engineVersion))
+}
@@ -0,0 +1,438 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+const (
+	// The default maximum replication lag before an upgraded read replica is promoted.
+	instanceUpgradeDefaultMaxReplicaLag = 10 * time.Second
+
+	instanceUpgradeReplicaIDSuffix = "-upgrade"
+	instanceUpgradeRetiredIDSuffix = "-retired"
+
+	// The timestamp that makes retired DB instance identifiers unique.
+	instanceUpgradeRetiredIDTimeFormat = "20060102150405"
+)
+
+// States of a replica promotion upgrade, in order.
+const (
+	instanceUpgradeStateCheckIDs       = "check-identifiers"
+	instanceUpgradeStateCreateReplica  = "create-replica"
+	instanceUpgradeStateUpgradeReplica = "upgrade-replica"
+	instanceUpgradeStateWaitReplicaLag = "wait-replica-lag"
+	instanceUpgradeStatePromoteReplica = "promote-replica"
+	instanceUpgradeStateRenameSource   = "rename-source"
+	instanceUpgradeStateRenameReplica  = "rename-replica"
+	instanceUpgradeStateDone           = "done"
+)
+
+// instanceReplicaPromotionUpgrade upgrades the engine of a DB instance by creating a read replica,
+// upgrading the replica, promoting it once it has caught up and swapping it in for the DB instance.
+// The DB instance is retired under a new identifier and left in place so that the upgrade can be
+// rolled back; it is no longer managed by Terraform and must be deleted by the user.
+// Progress is saved after each step, so that an upgrade that fails after the replica has been
+// promoted is resumed by the next apply.
+type instanceReplicaPromotionUpgrade struct {
+	conn rdsiface.RDSAPI
+
+	waitAvailable  func(id string) error
+	waitDeleted    func(id string) error
+	waitRenamed    func(newID string) error
+	waitReplicaLag func(id string) error
+
+	// saveState saves the next step to run, or "" once the upgrade is complete or has been rolled back.
+	saveState func(state string) error
+
+	id        string
+	replicaID string
+	retiredID string
+
+	engineVersion         string
+	parameterGroupName    string
+	replicaInput          *rds.CreateDBInstanceReadReplicaInput
+	backupRetentionPeriod int64
+	backupWindow          string
+	deletionProtection    bool
+
+	// state is the next step to run.
+	state string
+}
+
+func newInstanceReplicaPromotionUpgrade(d *schema.ResourceData, meta interface{}) *instanceReplicaPromotionUpgrade {
+	conn := meta.(*conns.AWSClient).RDSConn
+	cloudWatchConn := meta.(*conns.AWSClient).CloudWatchConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	maxReplicaLag := instanceUpgradeDefaultMaxReplicaLag
+	if v, ok := d.GetOk("upgrade_max_replica_lag"); ok {
+		maxReplicaLag = time.Duration(v.(int)) * time.Second
+	}
+
+	replicaInput := &rds.CreateDBInstanceReadReplicaInput{
+		AutoMinorVersionUpgrade: aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
+		CopyTagsToSnapshot:      aws.Bool(d.Get("copy_tags_to_snapshot").(bool)),
+		DBInstanceClass:         aws.String(d.Get("instance_class").(string)),
+		PubliclyAccessible:      aws.Bool(d.Get("publicly_accessible").(bool)),
+		Tags:                    Tags(tags.IgnoreAWS()),
+	}
+
+	if v, ok := d.GetOk("enabled_cloudwatch_logs_exports"); ok && v.(*schema.Set).Len() > 0 {
+		replicaInput.EnableCloudwatchLogsExports = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("iam_database_authentication_enabled"); ok {
+		replicaInput.EnableIAMDatabaseAuthentication = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("iops"); ok {
+		replicaInput.Iops = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("monitoring_interval"); ok {
+		replicaInput.MonitoringInterval = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("monitoring_role_arn"); ok {
+		replicaInput.MonitoringRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("multi_az"); ok {
+		replicaInput.MultiAZ = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("network_type"); ok {
+		replicaInput.NetworkType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("performance_insights_enabled"); ok {
+		replicaInput.EnablePerformanceInsights = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("performance_insights_kms_key_id"); ok {
+		replicaInput.PerformanceInsightsKMSKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("port"); ok {
+		replicaInput.Port = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("storage_type"); ok {
+		replicaInput.StorageType = aws.String(v.(string))
+	}
+
+	if v := d.Get("vpc_security_group_ids").(*schema.Set); v.Len() > 0 {
+		replicaInput.VpcSecurityGroupIds = flex.ExpandStringSet(v)
+	}
+
+	var parameterGroupName string
+	if d.HasChange("parameter_group_name") {
+		parameterGroupName = d.Get("parameter_group_name").(string)
+	}
+
+	u := &instanceReplicaPromotionUpgrade{
+		conn: conn,
+
+		waitAvailable: func(id string) error {
+			return waitUntilDBInstanceAvailableAfterUpdate(id, conn, timeout)
+		},
+		waitDeleted: func(id string) error {
+			_, err := waitDBInstanceDeleted(conn, id, timeout)
+
+			return err
+		},
+		waitRenamed: func(newID string) error {
+			_, err := waitDBInstanceRenamed(conn, newID, timeout)
+
+			return err
+		},
+		waitReplicaLag: func(id string) error {
+			return waitDBInstanceReplicaLagWithinThreshold(cloudWatchConn, id, maxReplicaLag, timeout)
+		},
+
+		id:        d.Id(),
+		replicaID: instanceUpgradeID(d.Id(), instanceUpgradeReplicaIDSuffix),
+		retiredID: instanceUpgradeID(d.Id(), instanceUpgradeRetiredIDSuffix+"-"+time.Now().UTC().Format(instanceUpgradeRetiredIDTimeFormat)),
+
+		engineVersion:         d.Get("engine_version").(string),
+		parameterGroupName:    parameterGroupName,
+		replicaInput:          replicaInput,
+		backupRetentionPeriod: int64(d.Get("backup_retention_period").(int)),
+		backupWindow:          d.Get("backup_window").(string),
+		deletionProtection:    d.Get("deletion_protection").(bool),
+	}
+
+	u.saveState = func(state string) error {
+		if state == "" {
+			return d.Set("upgrade_progress", nil)
+		}
+
+		return d.Set("upgrade_progress", []interface{}{map[string]interface{}{
+			"replica_identifier": u.replicaID,
+			"retired_identifier": u.retiredID,
+			"step":               state,
+		}})
+	}
+
+	// Resume a failed upgrade.
+	if v, ok := d.GetOk("upgrade_progress"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		u.replicaID = tfMap["replica_identifier"].(string)
+		u.retiredID = tfMap["retired_identifier"].(string)
+		u.state = tfMap["step"].(string)
+	}
+
+	return u
+}
+
+// instanceUpgradeStep returns the step at which an upgrade of the DB instance failed, if any.
+func instanceUpgradeStep(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("upgrade_progress"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		return v.([]interface{})[0].(map[string]interface{})["step"].(string)
+	}
+
+	return ""
+}
+
+// instanceUpgradeID returns a DB instance identifier derived from id, truncated to the maximum length of 63 characters.
+func instanceUpgradeID(id, suffix string) string {
+	const maxLength = 63
+
+	if len(id)+len(suffix) > maxLength {
+		id = strings.TrimRight(id[:maxLength-len(suffix)], "-")
+	}
+
+	return id + suffix
+}
+
+// run runs the upgrade to completion, starting from the saved step if any.
+// If the upgrade fails before the replica is promoted, the replica is deleted and the DB instance is left unchanged.
+func (u *instanceReplicaPromotionUpgrade) run() error {
+	if u.state == "" {
+		u.state = instanceUpgradeStateCheckIDs
+	} else {
+		log.Printf("[INFO] Resuming upgrade of DB Instance (%s) by replica promotion: %s", u.id, u.state)
+	}
+
+	for u.state != instanceUpgradeStateDone {
+		log.Printf("[DEBUG] Upgrading DB Instance (%s) by replica promotion: %s", u.id, u.state)
+		next, err := u.step(u.state)
+
+		if err != nil {
+			return u.fail(err)
+		}
+
+		u.state = next
+
+		state := next
+		if state == instanceUpgradeStateDone {
+			state = ""
+		}
+
+		if err := u.saveState(state); err != nil {
+			return fmt.Errorf("error saving upgrade progress: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (u *instanceReplicaPromotionUpgrade) step(state string) (string, error) {
+	switch state {
+	case instanceUpgradeStateCheckIDs:
+		// A DB instance left by an earlier upgrade must not be discovered after the replica has been promoted.
+		for _, id := range []string{u.replicaID, u.retiredID} {
+			_, err := u.conn.DescribeDBInstances(&rds.DescribeDBInstancesInput{DBInstanceIdentifier: aws.String(id)})
+
+			if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
+				continue
+			}
+
+			if err != nil {
+				return "", fmt.Errorf("error reading DB Instance (%s): %w", id, err)
+			}
+
+			return "", fmt.Errorf("DB Instance (%s) already exists", id)
+		}
+
+		return instanceUpgradeStateCreateReplica, nil
+
+	case instanceUpgradeStateCreateReplica:
+		input := *u.replicaInput
+		input.DBInstanceIdentifier = aws.String(u.replicaID)
+		input.SourceDBInstanceIdentifier = aws.String(u.id)
+
+		if _, err := u.conn.CreateDBInstanceReadReplica(&input); err != nil {
+			return "", fmt.Errorf("error creating read replica (%s): %w", u.replicaID, err)
+		}
+
+		if err := u.waitAvailable(u.replicaID); err != nil {
+			return "", fmt.Errorf("error waiting for read replica (%s) to be available: %w", u.replicaID, err)
+		}
+
+		return instanceUpgradeStateUpgradeReplica, nil
+
+	case instanceUpgradeStateUpgradeReplica:
+		input := &rds.ModifyDBInstanceInput{
+			AllowMajorVersionUpgrade: aws.Bool(true),
+			ApplyImmediately:         aws.Bool(true),
+			DBInstanceIdentifier:     aws.String(u.replicaID),
+			EngineVersion:            aws.String(u.engineVersion),
+		}
+
+		if u.parameterGroupName != "" {
+			input.DBParameterGroupName = aws.String(u.parameterGroupName)
+		}
+
+		if _, err := u.conn.ModifyDBInstance(input); err != nil {
+			return "", fmt.Errorf("error upgrading read replica (%s): %w", u.replicaID, err)
+		}
+
+		if err := u.waitAvailable(u.replicaID); err != nil {
+			return "", fmt.Errorf("error waiting for read replica (%s) to be available: %w", u.replicaID, err)
+		}
+
+		// A new DB parameter group only takes effect after a reboot.
+		if u.parameterGroupName != "" {
+			if _, err := u.conn.RebootDBInstance(&rds.RebootDBInstanceInput{DBInstanceIdentifier: aws.String(u.replicaID)}); err != nil {
+				return "", fmt.Errorf("error rebooting read replica (%s): %w", u.replicaID, err)
+			}
+
+			if err := u.waitAvailable(u.replicaID); err != nil {
+				return "", fmt.Errorf("error waiting for read replica (%s) to be available: %w", u.replicaID, err)
+			}
+		}
+
+		return instanceUpgradeStateWaitReplicaLag, nil
+
+	case instanceUpgradeStateWaitReplicaLag:
+		if err := u.waitReplicaLag(u.replicaID); err != nil {
+			return "", fmt.Errorf("error waiting for read replica (%s) to catch up: %w", u.replicaID, err)
+		}
+
+		return instanceUpgradeStatePromoteReplica, nil
+
+	case instanceUpgradeStatePromoteReplica:
+		input := &rds.PromoteReadReplicaInput{
+			BackupRetentionPeriod: aws.Int64(u.backupRetentionPeriod),
+			DBInstanceIdentifier:  aws.String(u.replicaID),
+		}
+
+		if u.backupWindow != "" {
+			input.PreferredBackupWindow = aws.String(u.backupWindow)
+		}
+
+		if _, err := u.conn.PromoteReadReplica(input); err != nil {
+			return "", fmt.Errorf("error promoting read replica (%s): %w", u.replicaID, err)
+		}
+
+		if err := u.waitAvailable(u.replicaID); err != nil {
+			return "", fmt.Errorf("error waiting for promoted read replica (%s) to be available: %w", u.replicaID, err)
+		}
+
+		return instanceUpgradeStateRenameSource, nil
+
+	case instanceUpgradeStateRenameSource:
+		input := &rds.ModifyDBInstanceInput{
+			ApplyImmediately:        aws.Bool(true),
+			DBInstanceIdentifier:    aws.String(u.id),
+			NewDBInstanceIdentifier: aws.String(u.retiredID),
+		}
+
+		if _, err := u.conn.ModifyDBInstance(input); err != nil {
+			return "", fmt.Errorf("error renaming DB Instance (%s) to %s: %w", u.id, u.retiredID, err)
+		}
+
+		if err := u.waitRenamed(u.retiredID); err != nil {
+			return "", fmt.Errorf("error waiting for DB Instance (%s) to be renamed: %w", u.retiredID, err)
+		}
+
+		return instanceUpgradeStateRenameReplica, nil
+
+	case instanceUpgradeStateRenameReplica:
+		input := &rds.ModifyDBInstanceInput{
+			ApplyImmediately:        aws.Bool(true),
+			DBInstanceIdentifier:    aws.String(u.replicaID),
+			DeletionProtection:      aws.Bool(u.deletionProtection),
+			NewDBInstanceIdentifier: aws.String(u.id),
+		}
+
+		if _, err := u.conn.ModifyDBInstance(input); err != nil {
+			return "", fmt.Errorf("error renaming DB Instance (%s) to %s: %w", u.replicaID, u.id, err)
+		}
+
+		if err := u.waitRenamed(u.id); err != nil {
+			return "", fmt.Errorf("error waiting for DB Instance (%s) to be renamed: %w", u.id, err)
+		}
+
+		log.Printf("[INFO] DB Instance (%s) upgraded, the previous DB Instance has been retired as %s and can be deleted", u.id, u.retiredID)
+
+		return instanceUpgradeStateDone, nil
+	}
+
+	return "", fmt.Errorf("unexpected state: %s", state)
+}
+
+// fail cleans up after a failed step.
+// The saved step is cleared once the upgrade has been rolled back, so that the next apply starts over.
+func (u *instanceReplicaPromotionUpgrade) fail(err error) error {
+	switch u.state {
+	case instanceUpgradeStateCheckIDs:
+		return err
+
+	case instanceUpgradeStateCreateReplica, instanceUpgradeStateUpgradeReplica, instanceUpgradeStateWaitReplicaLag:
+		log.Printf("[WARN] Upgrading DB Instance (%s) failed, deleting read replica (%s)", u.id, u.replicaID)
+
+		input := &rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier:   aws.String(u.replicaID),
+			DeleteAutomatedBackups: aws.Bool(true),
+			SkipFinalSnapshot:      aws.Bool(true),
+		}
+
+		if _, deleteErr := u.conn.DeleteDBInstance(input); deleteErr != nil {
+			if tfawserr.ErrCodeEquals(deleteErr, rds.ErrCodeDBInstanceNotFoundFault) {
+				if saveErr := u.saveState(""); saveErr != nil {
+					return multierror.Append(err, fmt.Errorf("error saving upgrade progress: %w", saveErr))
+				}
+
+				return err
+			}
+
+			return multierror.Append(err, fmt.Errorf("error deleting read replica (%s): %w", u.replicaID, deleteErr))
+		}
+
+		if waitErr := u.waitDeleted(u.replicaID); waitErr != nil {
+			return multierror.Append(err, fmt.Errorf("error waiting for read replica (%s) to be deleted: %w", u.replicaID, waitErr))
+		}
+
+		if saveErr := u.saveState(""); saveErr != nil {
+			return multierror.Append(err, fmt.Errorf("error saving upgrade progress: %w", saveErr))
+		}
+
+		return err
+
+	case instanceUpgradeStateRenameSource:
+		return fmt.Errorf("%w; the upgraded DB Instance (%s) has been promoted and the DB Instance (%s) is unchanged, the next apply resumes the upgrade", err, u.replicaID, u.id)
+
+	case instanceUpgradeStateRenameReplica:
+		return fmt.Errorf("%w; the upgraded DB Instance (%s) has been promoted and the DB Instance has been renamed to %s, the next apply resumes the upgrade", err, u.replicaID, u.retiredID)
+	}
+
+	return err
+}
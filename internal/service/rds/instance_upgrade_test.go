@@ -0,0 +1,316 @@
+package rds
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+)
+
+// fakeInstanceUpgradeConn records the RDS API calls of a replica promotion upgrade.
+type fakeInstanceUpgradeConn struct {
+	rdsiface.RDSAPI
+
+	calls []string
+	// errs maps a call, as recorded, to the error it returns.
+	errs map[string]error
+	// existing is the set of DB instance identifiers that are in use.
+	existing map[string]bool
+}
+
+func (c *fakeInstanceUpgradeConn) call(call string) error {
+	c.calls = append(c.calls, call)
+
+	return c.errs[call]
+}
+
+func (c *fakeInstanceUpgradeConn) CreateDBInstanceReadReplica(input *rds.CreateDBInstanceReadReplicaInput) (*rds.CreateDBInstanceReadReplicaOutput, error) {
+	return &rds.CreateDBInstanceReadReplicaOutput{}, c.call(fmt.Sprintf("CreateDBInstanceReadReplica %s from %s", aws.StringValue(input.DBInstanceIdentifier), aws.StringValue(input.SourceDBInstanceIdentifier)))
+}
+
+func (c *fakeInstanceUpgradeConn) DeleteDBInstance(input *rds.DeleteDBInstanceInput) (*rds.DeleteDBInstanceOutput, error) {
+	call := fmt.Sprintf("DeleteDBInstance %s", aws.StringValue(input.DBInstanceIdentifier))
+
+	if aws.BoolValue(input.SkipFinalSnapshot) {
+		call += " without snapshot"
+	} else {
+		call += fmt.Sprintf(" with snapshot %s", aws.StringValue(input.FinalDBSnapshotIdentifier))
+	}
+
+	return &rds.DeleteDBInstanceOutput{}, c.call(call)
+}
+
+func (c *fakeInstanceUpgradeConn) DescribeDBInstances(input *rds.DescribeDBInstancesInput) (*rds.DescribeDBInstancesOutput, error) {
+	id := aws.StringValue(input.DBInstanceIdentifier)
+
+	if err := c.call(fmt.Sprintf("DescribeDBInstances %s", id)); err != nil {
+		return nil, err
+	}
+
+	if !c.existing[id] {
+		return nil, awserr.New(rds.ErrCodeDBInstanceNotFoundFault, "not found", nil)
+	}
+
+	return &rds.DescribeDBInstancesOutput{DBInstances: []*rds.DBInstance{{DBInstanceIdentifier: aws.String(id)}}}, nil
+}
+
+func (c *fakeInstanceUpgradeConn) ModifyDBInstance(input *rds.ModifyDBInstanceInput) (*rds.ModifyDBInstanceOutput, error) {
+	call := fmt.Sprintf("ModifyDBInstance %s", aws.StringValue(input.DBInstanceIdentifier))
+
+	if input.EngineVersion != nil {
+		call += fmt.Sprintf(" engine version %s", aws.StringValue(input.EngineVersion))
+	}
+
+	if input.DBParameterGroupName != nil {
+		call += fmt.Sprintf(" parameter group %s", aws.StringValue(input.DBParameterGroupName))
+	}
+
+	if input.NewDBInstanceIdentifier != nil {
+		call += fmt.Sprintf(" rename to %s", aws.StringValue(input.NewDBInstanceIdentifier))
+	}
+
+	return &rds.ModifyDBInstanceOutput{}, c.call(call)
+}
+
+func (c *fakeInstanceUpgradeConn) PromoteReadReplica(input *rds.PromoteReadReplicaInput) (*rds.PromoteReadReplicaOutput, error) {
+	return &rds.PromoteReadReplicaOutput{}, c.call(fmt.Sprintf("PromoteReadReplica %s", aws.StringValue(input.DBInstanceIdentifier)))
+}
+
+func (c *fakeInstanceUpgradeConn) RebootDBInstance(input *rds.RebootDBInstanceInput) (*rds.RebootDBInstanceOutput, error) {
+	return &rds.RebootDBInstanceOutput{}, c.call(fmt.Sprintf("RebootDBInstance %s", aws.StringValue(input.DBInstanceIdentifier)))
+}
+
+func testInstanceReplicaPromotionUpgrade(conn *fakeInstanceUpgradeConn, parameterGroupName string, savedState *string) *instanceReplicaPromotionUpgrade {
+	wait := func(name string) func(string) error {
+		return func(id string) error {
+			return conn.call(fmt.Sprintf("%s %s", name, id))
+		}
+	}
+
+	return &instanceReplicaPromotionUpgrade{
+		conn: conn,
+
+		waitAvailable:  wait("waitAvailable"),
+		waitDeleted:    wait("waitDeleted"),
+		waitRenamed:    wait("waitRenamed"),
+		waitReplicaLag: wait("waitReplicaLag"),
+
+		saveState: func(state string) error {
+			*savedState = state
+
+			return nil
+		},
+
+		id:        "test",
+		replicaID: "test-upgrade",
+		retiredID: "test-retired",
+
+		engineVersion:      "8.0",
+		parameterGroupName: parameterGroupName,
+		replicaInput:       &rds.CreateDBInstanceReadReplicaInput{},
+	}
+}
+
+func TestInstanceReplicaPromotionUpgrade(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		ParameterGroupName string
+		Errs               map[string]error
+		Existing           map[string]bool
+		State              string
+		ExpectedCalls      []string
+		ExpectedError      string
+		ExpectedState      string
+	}{
+		{
+			Name: "success",
+			ExpectedCalls: []string{
+				"DescribeDBInstances test-upgrade",
+				"DescribeDBInstances test-retired",
+				"CreateDBInstanceReadReplica test-upgrade from test",
+				"waitAvailable test-upgrade",
+				"ModifyDBInstance test-upgrade engine version 8.0",
+				"waitAvailable test-upgrade",
+				"waitReplicaLag test-upgrade",
+				"PromoteReadReplica test-upgrade",
+				"waitAvailable test-upgrade",
+				"ModifyDBInstance test rename to test-retired",
+				"waitRenamed test-retired",
+				"ModifyDBInstance test-upgrade rename to test",
+				"waitRenamed test",
+			},
+		},
+		{
+			Name:               "parameter group",
+			ParameterGroupName: "mysql80",
+			ExpectedCalls: []string{
+				"DescribeDBInstances test-upgrade",
+				"DescribeDBInstances test-retired",
+				"CreateDBInstanceReadReplica test-upgrade from test",
+				"waitAvailable test-upgrade",
+				"ModifyDBInstance test-upgrade engine version 8.0 parameter group mysql80",
+				"waitAvailable test-upgrade",
+				"RebootDBInstance test-upgrade",
+				"waitAvailable test-upgrade",
+				"waitReplicaLag test-upgrade",
+				"PromoteReadReplica test-upgrade",
+				"waitAvailable test-upgrade",
+				"ModifyDBInstance test rename to test-retired",
+				"waitRenamed test-retired",
+				"ModifyDBInstance test-upgrade rename to test",
+				"waitRenamed test",
+			},
+		},
+		{
+			Name: "create replica error",
+			Errs: map[string]error{
+				"CreateDBInstanceReadReplica test-upgrade from test": errors.New("quota exceeded"),
+				"DeleteDBInstance test-upgrade without snapshot":     awserr.New(rds.ErrCodeDBInstanceNotFoundFault, "not found", nil),
+			},
+			ExpectedCalls: []string{
+				"DescribeDBInstances test-upgrade",
+				"DescribeDBInstances test-retired",
+				"CreateDBInstanceReadReplica test-upgrade from test",
+				"DeleteDBInstance test-upgrade without snapshot",
+			},
+			ExpectedError: "error creating read replica (test-upgrade): quota exceeded",
+		},
+		{
+			Name: "replica lag error",
+			Errs: map[string]error{
+				"waitReplicaLag test-upgrade": errors.New("timeout"),
+			},
+			ExpectedCalls: []string{
+				"DescribeDBInstances test-upgrade",
+				"DescribeDBInstances test-retired",
+				"CreateDBInstanceReadReplica test-upgrade from test",
+				"waitAvailable test-upgrade",
+				"ModifyDBInstance test-upgrade engine version 8.0",
+				"waitAvailable test-upgrade",
+				"waitReplicaLag test-upgrade",
+				"DeleteDBInstance test-upgrade without snapshot",
+				"waitDeleted test-upgrade",
+			},
+			ExpectedError: "error waiting for read replica (test-upgrade) to catch up: timeout",
+		},
+		{
+			Name:     "retired identifier in use",
+			Existing: map[string]bool{"test-retired": true},
+			ExpectedCalls: []string{
+				"DescribeDBInstances test-upgrade",
+				"DescribeDBInstances test-retired",
+			},
+			ExpectedError: "DB Instance (test-retired) already exists",
+		},
+		{
+			Name: "rollback error",
+			Errs: map[string]error{
+				"ModifyDBInstance test-upgrade engine version 8.0": errors.New("invalid version"),
+				"DeleteDBInstance test-upgrade without snapshot":   errors.New("throttled"),
+			},
+			ExpectedCalls: []string{
+				"DescribeDBInstances test-upgrade",
+				"DescribeDBInstances test-retired",
+				"CreateDBInstanceReadReplica test-upgrade from test",
+				"waitAvailable test-upgrade",
+				"ModifyDBInstance test-upgrade engine version 8.0",
+				"DeleteDBInstance test-upgrade without snapshot",
+			},
+			ExpectedError: "error deleting read replica (test-upgrade): throttled",
+			ExpectedState: instanceUpgradeStateUpgradeReplica,
+		},
+		{
+			Name: "rename error",
+			Errs: map[string]error{
+				"ModifyDBInstance test-upgrade rename to test": errors.New("already exists"),
+			},
+			ExpectedCalls: []string{
+				"DescribeDBInstances test-upgrade",
+				"DescribeDBInstances test-retired",
+				"CreateDBInstanceReadReplica test-upgrade from test",
+				"waitAvailable test-upgrade",
+				"ModifyDBInstance test-upgrade engine version 8.0",
+				"waitAvailable test-upgrade",
+				"waitReplicaLag test-upgrade",
+				"PromoteReadReplica test-upgrade",
+				"waitAvailable test-upgrade",
+				"ModifyDBInstance test rename to test-retired",
+				"waitRenamed test-retired",
+				"ModifyDBInstance test-upgrade rename to test",
+			},
+			ExpectedError: "the DB Instance has been renamed to test-retired",
+			ExpectedState: instanceUpgradeStateRenameReplica,
+		},
+		{
+			Name:  "resume",
+			State: instanceUpgradeStateRenameReplica,
+			ExpectedCalls: []string{
+				"ModifyDBInstance test-upgrade rename to test",
+				"waitRenamed test",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := &fakeInstanceUpgradeConn{errs: testCase.Errs, existing: testCase.Existing}
+			var savedState string
+
+			u := testInstanceReplicaPromotionUpgrade(conn, testCase.ParameterGroupName, &savedState)
+			u.state = testCase.State
+			err := u.run()
+
+			if testCase.ExpectedError == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedError != "" && (err == nil || !strings.Contains(err.Error(), testCase.ExpectedError)) {
+				t.Errorf("expected error containing %q, got %v", testCase.ExpectedError, err)
+			}
+
+			if !reflect.DeepEqual(conn.calls, testCase.ExpectedCalls) {
+				t.Errorf("expected calls:\n%s\ngot:\n%s", strings.Join(testCase.ExpectedCalls, "\n"), strings.Join(conn.calls, "\n"))
+			}
+
+			if savedState != testCase.ExpectedState {
+				t.Errorf("expected saved state %q, got %q", testCase.ExpectedState, savedState)
+			}
+		})
+	}
+}
+
+func TestInstanceUpgradeID(t *testing.T) {
+	testCases := []struct {
+		ID       string
+		Suffix   string
+		Expected string
+	}{
+		{
+			ID:       "test",
+			Suffix:   "-upgrade",
+			Expected: "test-upgrade",
+		},
+		{
+			ID:       strings.Repeat("a", 63),
+			Suffix:   "-upgrade",
+			Expected: strings.Repeat("a", 55) + "-upgrade",
+		},
+		{
+			ID:       strings.Repeat("a", 54) + "-bbbbbbbb",
+			Suffix:   "-upgrade",
+			Expected: strings.Repeat("a", 54) + "-upgrade",
+		},
+	}
+
+	for _, testCase := range testCases {
+		if got := instanceUpgradeID(testCase.ID, testCase.Suffix); got != testCase.Expected {
+			t.Errorf("instanceUpgradeID(%q, %q) = %q, expected %q", testCase.ID, testCase.Suffix, got, testCase.Expected)
+		}
+	}
+}
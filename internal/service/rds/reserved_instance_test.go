@@ -0,0 +1,182 @@
+package rds_test
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+)
+
+// Purchasing a reservation cannot be undone and is billed for its whole term.
+func TestAccRDSReservedInstance_basic(t *testing.T) {
+	key := "RUN_RDS_RESERVED_INSTANCE_TESTS"
+	if os.Getenv(key) != "true" {
+		t.Skipf("Environment variable %s is not set to true", key)
+	}
+
+	var reservation rds.ReservedDBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_reserved_instance.test"
+	dataSourceName := "data.aws_rds_reserved_instance_offering.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReservedInstanceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReservedInstanceExists(resourceName, &reservation),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rds", regexp.MustCompile(`ri:.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "currency_code", dataSourceName, "currency_code"),
+					resource.TestCheckResourceAttrPair(resourceName, "duration", dataSourceName, "duration"),
+					resource.TestCheckResourceAttrPair(resourceName, "fixed_price", dataSourceName, "fixed_price"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_class", dataSourceName, "db_instance_class"),
+					resource.TestCheckResourceAttr(resourceName, "instance_count", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "lease_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "multi_az", dataSourceName, "multi_az"),
+					resource.TestCheckResourceAttrPair(resourceName, "offering_id", dataSourceName, "offering_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "offering_type", dataSourceName, "offering_type"),
+					resource.TestCheckResourceAttrPair(resourceName, "product_description", dataSourceName, "product_description"),
+					resource.TestCheckResourceAttr(resourceName, "reservation_id", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttr(resourceName, "state", tfrds.ReservedInstanceStateActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestReservedInstanceUpdate_tags(t *testing.T) {
+	reservationARN := "arn:aws:rds:us-west-2:123456789012:ri:test" //lintignore:AWSAT003,AWSAT005
+	var calls []string
+
+	r := tfrds.ResourceReservedInstance()
+	meta := acctest.UnitTestClient(t, acctest.UnitTestFakes{
+		"AddTagsToResource": func(input interface{}) (interface{}, error) {
+			for _, tag := range input.(*rds.AddTagsToResourceInput).Tags {
+				calls = append(calls, fmt.Sprintf("AddTagsToResource %s=%s", aws.StringValue(tag.Key), aws.StringValue(tag.Value)))
+			}
+
+			return &rds.AddTagsToResourceOutput{}, nil
+		},
+		"RemoveTagsFromResource": func(input interface{}) (interface{}, error) {
+			for _, key := range input.(*rds.RemoveTagsFromResourceInput).TagKeys {
+				calls = append(calls, fmt.Sprintf("RemoveTagsFromResource %s", aws.StringValue(key)))
+			}
+
+			return &rds.RemoveTagsFromResourceOutput{}, nil
+		},
+		"DescribeReservedDBInstances": func(input interface{}) (interface{}, error) {
+			return &rds.DescribeReservedDBInstancesOutput{
+				ReservedDBInstances: []*rds.ReservedDBInstance{{
+					DBInstanceCount:               aws.Int64(1),
+					ReservedDBInstanceArn:         aws.String(reservationARN),
+					ReservedDBInstanceId:          aws.String("test"),
+					ReservedDBInstancesOfferingId: aws.String("438012d3-4052-4cc7-b2e3-8d3372e0e706"),
+					State:                         aws.String(tfrds.ReservedInstanceStateActive),
+				}},
+			}, nil
+		},
+		"ListTagsForResource": func(input interface{}) (interface{}, error) {
+			return &rds.ListTagsForResourceOutput{
+				TagList: []*rds.Tag{{Key: aws.String("key2"), Value: aws.String("value2")}},
+			}, nil
+		},
+	})
+
+	state := acctest.UnitTestState("test", map[string]string{
+		"arn":            reservationARN,
+		"instance_count": "1",
+		"offering_id":    "438012d3-4052-4cc7-b2e3-8d3372e0e706",
+		"reservation_id": "test",
+		"tags.%":         "1",
+		"tags.key1":      "value1",
+		"tags_all.%":     "1",
+		"tags_all.key1":  "value1",
+	})
+
+	d, err := acctest.UnitTestUpdate(t, r, state, map[string]interface{}{
+		"offering_id": "438012d3-4052-4cc7-b2e3-8d3372e0e706",
+		"tags": map[string]interface{}{
+			"key2": "value2",
+		},
+	}, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := []string{"RemoveTagsFromResource key1", "AddTagsToResource key2=value2"}; !reflect.DeepEqual(calls, expected) {
+		t.Errorf("expected calls %v, got %v", expected, calls)
+	}
+
+	if expected, got := map[string]interface{}{"key2": "value2"}, d.Get("tags_all"); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected tags_all %v, got %v", expected, got)
+	}
+}
+
+func testAccCheckReservedInstanceExists(n string, v *rds.ReservedDBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RDS Reserved Instance ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+		output, err := tfrds.FindReservedDBInstanceByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccReservedInstanceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_rds_reserved_instance_offering" "test" {
+  db_instance_class   = "db.t3.micro"
+  duration            = 31536000
+  multi_az            = false
+  offering_type       = "All Upfront"
+  product_description = "mysql"
+}
+
+resource "aws_rds_reserved_instance" "test" {
+  offering_id    = data.aws_rds_reserved_instance_offering.test.offering_id
+  reservation_id = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
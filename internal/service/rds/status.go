@@ -2,8 +2,10 @@ package rds
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -142,6 +144,24 @@ func statusDBInstanceHasAutomatedBackup(conn *rds.RDS, dbInstanceID, dbInstanceA
 	}
 }
 
+// statusDBInstanceReplicaLag returns whether or not a read replica's most recent replication lag is within a threshold.
+func statusDBInstanceReplicaLag(conn *cloudwatch.CloudWatch, id string, threshold time.Duration) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		lag, err := findDBInstanceReplicaLag(conn, id)
+
+		// The metric is not published until replication has started.
+		if tfresource.NotFound(err) {
+			return id, strconv.FormatBool(false), nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return lag, strconv.FormatBool(lag <= threshold), nil
+	}
+}
+
 func statusDBProxy(conn *rds.RDS, name string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindDBProxyByName(conn, name)
@@ -157,3 +177,19 @@ func statusDBProxy(conn *rds.RDS, name string) resource.StateRefreshFunc {
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+func statusReservedDBInstance(conn *rds.RDS, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindReservedDBInstanceByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
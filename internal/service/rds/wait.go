@@ -7,8 +7,10 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -29,7 +31,7 @@ func waitEventSubscriptionCreated(conn *rds.RDS, id string, timeout time.Duratio
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*rds.EventSubscription); ok {
 		return output, err
@@ -48,7 +50,7 @@ func waitEventSubscriptionDeleted(conn *rds.RDS, id string, timeout time.Duratio
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*rds.EventSubscription); ok {
 		return output, err
@@ -68,7 +70,7 @@ func waitEventSubscriptionUpdated(conn *rds.RDS, id string, timeout time.Duratio
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*rds.EventSubscription); ok {
 		return output, err
@@ -89,7 +91,7 @@ func waitDBProxyEndpointAvailable(conn *rds.RDS, id string, timeout time.Duratio
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*rds.DBProxyEndpoint); ok {
 		return output, err
@@ -107,7 +109,7 @@ func waitDBProxyEndpointDeleted(conn *rds.RDS, id string, timeout time.Duration)
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*rds.DBProxyEndpoint); ok {
 		return output, err
@@ -124,7 +126,7 @@ func waitDBClusterRoleAssociationCreated(conn *rds.RDS, dbClusterID, roleARN str
 		Timeout: dbClusterRoleAssociationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*rds.DBClusterRole); ok {
 		return output, err
@@ -141,7 +143,7 @@ func waitDBClusterRoleAssociationDeleted(conn *rds.RDS, dbClusterID, roleARN str
 		Timeout: dbClusterRoleAssociationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*rds.DBClusterRole); ok {
 		return output, err
@@ -175,7 +177,7 @@ func waitDBInstanceDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rd
 		ContinuousTargetOccurence: 3,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -184,6 +186,43 @@ func waitDBInstanceDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rd
 	return nil, err
 }
 
+// waitDBInstanceRenamed waits for a renamed DB instance to be available under its new identifier.
+func waitDBInstanceRenamed(conn *rds.RDS, newID string, timeout time.Duration) (*rds.DBInstance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:        append([]string{InstanceStatusRenaming}, resourceInstanceUpdatePendingStates...),
+		Target:         []string{InstanceStatusAvailable},
+		Refresh:        statusDBInstance(conn, newID),
+		Timeout:        timeout,
+		MinTimeout:     10 * time.Second,
+		Delay:          30 * time.Second,
+		NotFoundChecks: 60,
+	}
+
+	outputRaw, err := tfresource.WaitForState(stateConf)
+
+	if output, ok := outputRaw.(*rds.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitDBInstanceReplicaLagWithinThreshold waits for a read replica's replication lag to be within a threshold.
+func waitDBInstanceReplicaLagWithinThreshold(conn *cloudwatch.CloudWatch, id string, threshold, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{strconv.FormatBool(false)},
+		Target:                    []string{strconv.FormatBool(true)},
+		Refresh:                   statusDBInstanceReplicaLag(conn, id, threshold),
+		Timeout:                   timeout,
+		MinTimeout:                30 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	_, err := tfresource.WaitForState(stateConf)
+
+	return err
+}
+
 func waitDBClusterInstanceDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
@@ -198,7 +237,7 @@ func waitDBClusterInstanceDeleted(conn *rds.RDS, id string, timeout time.Duratio
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -220,7 +259,7 @@ func waitActivityStreamStarted(ctx context.Context, conn *rds.RDS, dbClusterArn
 		Delay:      30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if err != nil {
 		return fmt.Errorf("error waiting for RDS Cluster Activity Stream (%s) to be started: %v", dbClusterArn, err)
 	}
@@ -240,7 +279,7 @@ func waitActivityStreamStopped(ctx context.Context, conn *rds.RDS, dbClusterArn
 		Delay:      30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if err != nil {
 		return fmt.Errorf("error waiting for RDS Cluster Activity Stream (%s) to be stopped: %v", dbClusterArn, err)
 	}
@@ -255,7 +294,7 @@ func waitDBInstanceAutomatedBackupCreated(conn *rds.RDS, arn string, timeout tim
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*rds.DBInstanceAutomatedBackup); ok {
 		return output, err
@@ -274,7 +313,7 @@ func waitDBInstanceAutomatedBackupDeleted(conn *rds.RDS, dbInstanceID, dbInstanc
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -291,7 +330,7 @@ func waitDBProxyCreated(conn *rds.RDS, name string, timeout time.Duration) (*rds
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*rds.DBProxy); ok {
 		return output, err
@@ -308,7 +347,7 @@ func waitDBProxyDeleted(conn *rds.RDS, name string, timeout time.Duration) (*rds
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*rds.DBProxy); ok {
 		return output, err
@@ -325,7 +364,7 @@ func waitDBProxyUpdated(conn *rds.RDS, name string, timeout time.Duration) (*rds
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*rds.DBProxy); ok {
 		return output, err
@@ -333,3 +372,22 @@ func waitDBProxyUpdated(conn *rds.RDS, name string, timeout time.Duration) (*rds
 
 	return nil, err
 }
+
+func waitReservedDBInstanceActive(conn *rds.RDS, id string, timeout time.Duration) (*rds.ReservedDBInstance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ReservedInstanceStatePaymentPending},
+		Target:     []string{ReservedInstanceStateActive},
+		Refresh:    statusReservedDBInstance(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForState(stateConf)
+
+	if output, ok := outputRaw.(*rds.ReservedDBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
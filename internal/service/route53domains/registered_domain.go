@@ -715,7 +715,7 @@ func waitOperationSucceeded(ctx context.Context, conn *route53domains.Client, id
 		Refresh: statusOperation(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53domains.GetOperationDetailOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
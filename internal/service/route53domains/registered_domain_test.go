@@ -2,15 +2,21 @@ package route53domains_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53domains "github.com/hashicorp/terraform-provider-aws/internal/service/route53domains"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -342,6 +348,103 @@ func testAccRoute53DomainsRegisteredDomain_transferLock(t *testing.T) {
 	})
 }
 
+func TestRegisteredDomainRead(t *testing.T) {
+	domainName := "example.com"
+
+	testCases := []struct {
+		Name               string
+		Fake               acctest.UnitTestFake
+		ExpectedAttributes map[string]string
+		ExpectedError      string
+		ExpectedRemoved    bool
+	}{
+		{
+			Name: "found",
+			Fake: func(input interface{}) (interface{}, error) {
+				if v := aws.ToString(input.(*route53domains.GetDomainDetailInput).DomainName); v != domainName {
+					return nil, fmt.Errorf("unexpected domain name: %s", v)
+				}
+
+				return &route53domains.GetDomainDetailOutput{
+					AutoRenew:      aws.Bool(true),
+					DomainName:     aws.String(domainName),
+					ExpirationDate: aws.Time(time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)),
+					Nameservers: []types.Nameserver{
+						{Name: aws.String("ns-1.example.net")},
+					},
+					RegistrarName: aws.String("Amazon Registrar, Inc."),
+					StatusList:    []string{"clientTransferProhibited"},
+				}, nil
+			},
+			ExpectedAttributes: map[string]string{
+				"auto_renew":         "true",
+				"domain_name":        domainName,
+				"expiration_date":    "2023-06-01T12:00:00Z",
+				"name_server.#":      "1",
+				"name_server.0.name": "ns-1.example.net",
+				"registrar_name":     "Amazon Registrar, Inc.",
+				"status_list.#":      "1",
+				"status_list.0":      "clientTransferProhibited",
+				"tags.%":             "1",
+				"tags.Name":          "test",
+				"transfer_lock":      "true",
+			},
+		},
+		{
+			Name: "not found",
+			Fake: func(input interface{}) (interface{}, error) {
+				return nil, &types.InvalidInput{Message: aws.String("Domain example.com not found in account 123456789012")}
+			},
+			ExpectedRemoved: true,
+		},
+		{
+			Name: "error",
+			Fake: func(input interface{}) (interface{}, error) {
+				return nil, &types.UnsupportedTLD{Message: aws.String("unsupported TLD")}
+			},
+			ExpectedError: "unsupported TLD",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := tfroute53domains.ResourceRegisteredDomain()
+			meta := acctest.UnitTestClient(t, acctest.UnitTestFakes{
+				"GetDomainDetail": testCase.Fake,
+				"ListTagsForDomain": func(input interface{}) (interface{}, error) {
+					return json.RawMessage(`{"TagList": [{"Key": "Name", "Value": "test"}]}`), nil
+				},
+			})
+
+			d, err := acctest.UnitTestRead(t, r, acctest.UnitTestState(domainName, nil), meta)
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if removed := d.Id() == ""; removed != testCase.ExpectedRemoved {
+				t.Fatalf("expected removed from state %t, got %t", testCase.ExpectedRemoved, removed)
+			}
+
+			state := d.State()
+
+			for k, expected := range testCase.ExpectedAttributes {
+				if got := state.Attributes[k]; got != expected {
+					t.Errorf("expected %s to be %q, got %q", k, expected, got)
+				}
+			}
+		})
+	}
+}
+
 func testAccCheckRegisteredDomainDestroy(s *terraform.State) error {
 	return nil
 }
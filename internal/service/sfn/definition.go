@@ -0,0 +1,650 @@
+package sfn
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Amazon States Language state types.
+const (
+	stateTypeChoice   = "Choice"
+	stateTypeFail     = "Fail"
+	stateTypeMap      = "Map"
+	stateTypeParallel = "Parallel"
+	stateTypePass     = "Pass"
+	stateTypeSucceed  = "Succeed"
+	stateTypeTask     = "Task"
+	stateTypeWait     = "Wait"
+)
+
+func stateType_Values() []string {
+	return []string{
+		stateTypeChoice,
+		stateTypeFail,
+		stateTypeMap,
+		stateTypeParallel,
+		stateTypePass,
+		stateTypeSucceed,
+		stateTypeTask,
+		stateTypeWait,
+	}
+}
+
+// choiceComparisonOperators are the Choice rule data-test expressions.
+var choiceComparisonOperators = func() map[string]struct{} {
+	m := map[string]struct{}{
+		"IsBoolean":   {},
+		"IsNull":      {},
+		"IsNumeric":   {},
+		"IsPresent":   {},
+		"IsString":    {},
+		"IsTimestamp": {},
+	}
+
+	for _, prefix := range []string{"Numeric", "String", "Timestamp"} {
+		for _, op := range []string{"Equals", "GreaterThan", "GreaterThanEquals", "LessThan", "LessThanEquals"} {
+			m[prefix+op] = struct{}{}
+			m[prefix+op+"Path"] = struct{}{}
+		}
+	}
+
+	m["BooleanEquals"] = struct{}{}
+	m["BooleanEqualsPath"] = struct{}{}
+	m["StringMatches"] = struct{}{}
+
+	return m
+}()
+
+// intrinsicFunctions are the Amazon States Language intrinsic functions.
+var intrinsicFunctions = map[string]struct{}{
+	"States.Array":          {},
+	"States.ArrayContains":  {},
+	"States.ArrayGetItem":   {},
+	"States.ArrayLength":    {},
+	"States.ArrayPartition": {},
+	"States.ArrayRange":     {},
+	"States.ArrayUnique":    {},
+	"States.Base64Decode":   {},
+	"States.Base64Encode":   {},
+	"States.Format":         {},
+	"States.Hash":           {},
+	"States.JsonMerge":      {},
+	"States.JsonToString":   {},
+	"States.MathAdd":        {},
+	"States.MathRandom":     {},
+	"States.StringSplit":    {},
+	"States.StringToJson":   {},
+	"States.UUID":           {},
+}
+
+var intrinsicFunctionNameRegexp = regexp.MustCompile(`^States\.[A-Za-z0-9]+`)
+
+func validStateMachineDefinition(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) > 1024*1024 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than %d characters", k, 1024*1024))
+		return
+	}
+
+	for _, err := range validateDefinition(value) {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
+
+// validateDefinition checks an Amazon States Language definition without calling AWS.
+// It returns one error per problem found, ordered by location.
+func validateDefinition(definition string) []error {
+	var machine map[string]interface{}
+
+	decoder := json.NewDecoder(strings.NewReader(definition))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&machine); err != nil {
+		return []error{fmt.Errorf("invalid JSON: %w", err)}
+	}
+
+	v := &definitionValidator{}
+	v.validateMachine("", machine)
+
+	sort.SliceStable(v.problems, func(i, j int) bool { return v.problems[i].path < v.problems[j].path })
+
+	errs := make([]error, len(v.problems))
+	for i, p := range v.problems {
+		errs[i] = p
+	}
+
+	return errs
+}
+
+type definitionProblem struct {
+	path    string
+	message string
+}
+
+func (p definitionProblem) Error() string {
+	if p.path == "" {
+		return p.message
+	}
+
+	return fmt.Sprintf("%s: %s", p.path, p.message)
+}
+
+type definitionValidator struct {
+	problems []definitionProblem
+}
+
+func (v *definitionValidator) addf(path, format string, a ...interface{}) {
+	v.problems = append(v.problems, definitionProblem{path: path, message: fmt.Sprintf(format, a...)})
+}
+
+// validateMachine validates a state machine or a Parallel branch or Map iterator, which have the same shape.
+func (v *definitionValidator) validateMachine(path string, machine map[string]interface{}) {
+	startAt, ok := machine["StartAt"].(string)
+
+	if !ok || startAt == "" {
+		v.addf(path, "StartAt is required and must be a string")
+	}
+
+	states, ok := machine["States"].(map[string]interface{})
+
+	if !ok || len(states) == 0 {
+		v.addf(path, "States is required and must be a non-empty object")
+		return
+	}
+
+	if startAt != "" {
+		if _, ok := states[startAt]; !ok {
+			v.addf(path, "StartAt (%s) does not refer to a state", startAt)
+		}
+	}
+
+	names := make([]string, 0, len(states))
+	for name := range states {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	edges := make(map[string][]string)
+
+	for _, name := range names {
+		statePath := joinDefinitionPath(path, "States", name)
+
+		if len(name) > 80 {
+			v.addf(statePath, "state name cannot be longer than 80 characters")
+		}
+
+		state, ok := states[name].(map[string]interface{})
+
+		if !ok {
+			v.addf(statePath, "state must be an object")
+			continue
+		}
+
+		edges[name] = v.validateState(statePath, state, states)
+	}
+
+	if _, ok := states[startAt]; !ok {
+		return
+	}
+
+	reachable := map[string]struct{}{startAt: {}}
+	queue := []string{startAt}
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		for _, next := range edges[name] {
+			if _, ok := reachable[next]; !ok {
+				reachable[next] = struct{}{}
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	for _, name := range names {
+		if _, ok := reachable[name]; !ok {
+			v.addf(joinDefinitionPath(path, "States", name), "state is not reachable from StartAt (%s)", startAt)
+		}
+	}
+}
+
+// validateState validates a single state and returns the names of the states it transitions to.
+func (v *definitionValidator) validateState(path string, state map[string]interface{}, states map[string]interface{}) []string {
+	var edges []string
+
+	refersTo := func(field string, value interface{}) {
+		next, ok := value.(string)
+
+		if !ok || next == "" {
+			v.addf(path, "%s must be a non-empty string", field)
+			return
+		}
+
+		if _, ok := states[next]; !ok {
+			v.addf(path, "%s (%s) does not refer to a state", field, next)
+			return
+		}
+
+		edges = append(edges, next)
+	}
+
+	stateType, _ := state["Type"].(string)
+
+	if !definitionStringInSlice(stateType, stateType_Values()) {
+		v.addf(path, "Type must be one of %s", strings.Join(stateType_Values(), ", "))
+		return nil
+	}
+
+	next, hasNext := state["Next"]
+	end, hasEnd := state["End"]
+
+	switch stateType {
+	case stateTypeChoice, stateTypeFail, stateTypeSucceed:
+		if hasNext || hasEnd {
+			v.addf(path, "%s states cannot have Next or End", stateType)
+		}
+	default:
+		if hasEnd {
+			if b, ok := end.(bool); !ok || !b {
+				v.addf(path, "End must be true if present")
+			}
+		}
+
+		switch {
+		case hasNext && hasEnd:
+			v.addf(path, "exactly one of Next or End is required, got both")
+		case !hasNext && !hasEnd:
+			v.addf(path, "exactly one of Next or End is required")
+		case hasNext:
+			refersTo("Next", next)
+		}
+	}
+
+	switch stateType {
+	case stateTypeTask:
+		if r, ok := state["Resource"].(string); !ok || r == "" {
+			v.addf(path, "Task states require Resource")
+		}
+
+	case stateTypeWait:
+		n := 0
+		for _, field := range []string{"Seconds", "SecondsPath", "Timestamp", "TimestampPath"} {
+			if _, ok := state[field]; ok {
+				n++
+			}
+		}
+
+		if n != 1 {
+			v.addf(path, "Wait states require exactly one of Seconds, SecondsPath, Timestamp or TimestampPath")
+		}
+
+	case stateTypeChoice:
+		choices, ok := state["Choices"].([]interface{})
+
+		if !ok || len(choices) == 0 {
+			v.addf(path, "Choice states require a non-empty Choices array")
+		}
+
+		for i, c := range choices {
+			rulePath := fmt.Sprintf("%s.Choices[%d]", path, i)
+			rule, ok := c.(map[string]interface{})
+
+			if !ok {
+				v.addf(rulePath, "choice rule must be an object")
+				continue
+			}
+
+			if next, ok := rule["Next"]; ok {
+				refersTo(fmt.Sprintf("Choices[%d].Next", i), next)
+			} else {
+				v.addf(rulePath, "top-level choice rules require Next")
+			}
+
+			v.validateChoiceRule(rulePath, rule)
+		}
+
+		if d, ok := state["Default"]; ok {
+			refersTo("Default", d)
+		}
+
+	case stateTypeParallel:
+		branches, ok := state["Branches"].([]interface{})
+
+		if !ok || len(branches) == 0 {
+			v.addf(path, "Parallel states require a non-empty Branches array")
+		}
+
+		for i, b := range branches {
+			branchPath := fmt.Sprintf("%s.Branches[%d]", path, i)
+			branch, ok := b.(map[string]interface{})
+
+			if !ok {
+				v.addf(branchPath, "branch must be an object")
+				continue
+			}
+
+			v.validateMachine(branchPath, branch)
+		}
+
+	case stateTypeMap:
+		field := "Iterator"
+		if _, ok := state["ItemProcessor"]; ok {
+			field = "ItemProcessor"
+		}
+
+		iterator, ok := state[field].(map[string]interface{})
+
+		if !ok {
+			v.addf(path, "Map states require an Iterator or ItemProcessor object")
+		} else {
+			v.validateMachine(path+"."+field, iterator)
+		}
+	}
+
+	for _, field := range []string{"Retry", "Catch"} {
+		raw, ok := state[field]
+
+		if !ok {
+			continue
+		}
+
+		if stateType != stateTypeTask && stateType != stateTypeParallel && stateType != stateTypeMap {
+			v.addf(path, "%s is only allowed in Task, Parallel and Map states", field)
+			continue
+		}
+
+		l, ok := raw.([]interface{})
+
+		if !ok {
+			v.addf(path, "%s must be an array", field)
+			continue
+		}
+
+		for i, r := range l {
+			itemPath := fmt.Sprintf("%s.%s[%d]", path, field, i)
+			item, ok := r.(map[string]interface{})
+
+			if !ok {
+				v.addf(itemPath, "must be an object")
+				continue
+			}
+
+			v.validateErrorEquals(itemPath, item)
+
+			if field == "Retry" {
+				v.validateRetrier(itemPath, item)
+			} else {
+				refersTo(fmt.Sprintf("Catch[%d].Next", i), item["Next"])
+			}
+		}
+	}
+
+	for _, field := range []string{"Parameters", "ResultSelector", "ItemSelector"} {
+		if p, ok := state[field]; ok {
+			v.validatePayloadTemplate(path+"."+field, p)
+		}
+	}
+
+	return edges
+}
+
+// validateChoiceRule validates a choice rule, which is either a Boolean expression or a data-test expression.
+func (v *definitionValidator) validateChoiceRule(path string, rule map[string]interface{}) {
+	var operators []string
+
+	for k := range rule {
+		if _, ok := choiceComparisonOperators[k]; ok {
+			operators = append(operators, k)
+		}
+	}
+
+	for _, k := range []string{"And", "Or", "Not"} {
+		if _, ok := rule[k]; ok {
+			operators = append(operators, k)
+		}
+	}
+
+	if len(operators) != 1 {
+		sort.Strings(operators)
+		v.addf(path, "choice rule requires exactly one comparison operator or And, Or or Not, got %d (%s)", len(operators), strings.Join(operators, ", "))
+		return
+	}
+
+	switch op := operators[0]; op {
+	case "And", "Or":
+		l, ok := rule[op].([]interface{})
+
+		if !ok || len(l) == 0 {
+			v.addf(path, "%s must be a non-empty array of choice rules", op)
+			return
+		}
+
+		for i, r := range l {
+			v.validateNestedChoiceRule(fmt.Sprintf("%s.%s[%d]", path, op, i), r)
+		}
+
+	case "Not":
+		v.validateNestedChoiceRule(path+".Not", rule[op])
+
+	default:
+		if s, ok := rule["Variable"].(string); !ok || !strings.HasPrefix(s, "$") {
+			v.addf(path, "%s requires Variable to be a path beginning with $", op)
+		}
+	}
+}
+
+func (v *definitionValidator) validateNestedChoiceRule(path string, raw interface{}) {
+	rule, ok := raw.(map[string]interface{})
+
+	if !ok {
+		v.addf(path, "choice rule must be an object")
+		return
+	}
+
+	if _, ok := rule["Next"]; ok {
+		v.addf(path, "nested choice rules cannot have Next")
+	}
+
+	v.validateChoiceRule(path, rule)
+}
+
+func (v *definitionValidator) validateErrorEquals(path string, item map[string]interface{}) {
+	l, ok := item["ErrorEquals"].([]interface{})
+
+	if !ok || len(l) == 0 {
+		v.addf(path, "ErrorEquals must be a non-empty array")
+		return
+	}
+
+	for _, e := range l {
+		if s, ok := e.(string); !ok || s == "" {
+			v.addf(path, "ErrorEquals must contain only non-empty strings")
+			return
+		}
+	}
+}
+
+func (v *definitionValidator) validateRetrier(path string, item map[string]interface{}) {
+	for _, f := range []struct {
+		field string
+		min   float64
+	}{
+		{"IntervalSeconds", 1},
+		{"MaxAttempts", 0},
+		{"BackoffRate", 1},
+	} {
+		field, min := f.field, f.min
+		raw, ok := item[field]
+
+		if !ok {
+			continue
+		}
+
+		n, ok := raw.(json.Number)
+
+		if !ok {
+			v.addf(path, "%s must be a number", field)
+			continue
+		}
+
+		f, err := n.Float64()
+
+		if err != nil || f < min {
+			v.addf(path, "%s must be a number greater than or equal to %g", field, min)
+			continue
+		}
+
+		if field != "BackoffRate" && strings.ContainsAny(n.String(), ".eE") {
+			v.addf(path, "%s must be an integer", field)
+		}
+	}
+}
+
+// validatePayloadTemplate checks the intrinsic function calls in a payload template.
+func (v *definitionValidator) validatePayloadTemplate(path string, raw interface{}) {
+	switch t := raw.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			value := t[k]
+
+			if s, ok := value.(string); ok && strings.HasSuffix(k, ".$") && strings.HasPrefix(s, "States.") {
+				if err := validIntrinsicFunction(s); err != nil {
+					v.addf(path+"."+k, "%s", err)
+				}
+
+				continue
+			}
+
+			v.validatePayloadTemplate(path+"."+k, value)
+		}
+
+	case []interface{}:
+		for i, item := range t {
+			v.validatePayloadTemplate(fmt.Sprintf("%s[%d]", path, i), item)
+		}
+	}
+}
+
+// validIntrinsicFunction checks the syntax of an intrinsic function call, including nested calls.
+func validIntrinsicFunction(s string) error {
+	rest, err := parseIntrinsicFunction(s)
+
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(rest) != "" {
+		return fmt.Errorf("unexpected %q after intrinsic function call", rest)
+	}
+
+	return nil
+}
+
+// parseIntrinsicFunction parses one intrinsic function call at the start of s and returns the remainder.
+func parseIntrinsicFunction(s string) (string, error) {
+	name := intrinsicFunctionNameRegexp.FindString(s)
+
+	if name == "" {
+		return "", fmt.Errorf("invalid intrinsic function call %q", s)
+	}
+
+	if _, ok := intrinsicFunctions[name]; !ok {
+		return "", fmt.Errorf("unknown intrinsic function %s", name)
+	}
+
+	rest := s[len(name):]
+
+	if !strings.HasPrefix(rest, "(") {
+		return "", fmt.Errorf("intrinsic function %s must be followed by (", name)
+	}
+
+	rest = strings.TrimLeft(rest[1:], " ")
+
+	if strings.HasPrefix(rest, ")") {
+		return rest[1:], nil
+	}
+
+	for {
+		var err error
+
+		switch {
+		case strings.HasPrefix(rest, "'"):
+			rest, err = parseIntrinsicStringLiteral(rest)
+		case strings.HasPrefix(rest, "States."):
+			rest, err = parseIntrinsicFunction(rest)
+		default:
+			// A path, number, boolean or null: everything up to the next separator.
+			i := strings.IndexAny(rest, ",)")
+
+			if i < 0 {
+				return "", fmt.Errorf("unterminated arguments to intrinsic function %s", name)
+			}
+
+			if strings.TrimSpace(rest[:i]) == "" {
+				return "", fmt.Errorf("empty argument to intrinsic function %s", name)
+			}
+
+			rest = rest[i:]
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		rest = strings.TrimLeft(rest, " ")
+
+		switch {
+		case strings.HasPrefix(rest, ","):
+			rest = strings.TrimLeft(rest[1:], " ")
+		case strings.HasPrefix(rest, ")"):
+			return rest[1:], nil
+		default:
+			return "", fmt.Errorf("unterminated arguments to intrinsic function %s", name)
+		}
+	}
+}
+
+// parseIntrinsicStringLiteral parses a single-quoted string, in which \ escapes the next character.
+func parseIntrinsicStringLiteral(s string) (string, error) {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '\'':
+			return s[i+1:], nil
+		}
+	}
+
+	return "", fmt.Errorf("unterminated string literal %s", s)
+}
+
+func joinDefinitionPath(path string, elems ...string) string {
+	if path == "" {
+		return strings.Join(elems, ".")
+	}
+
+	return path + "." + strings.Join(elems, ".")
+}
+
+func definitionStringInSlice(s string, l []string) bool {
+	for _, v := range l {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,176 @@
+package sfn
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateDefinition(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Definition string
+		Expected   []string
+	}{
+		{
+			Name: "valid",
+			Definition: `{
+  "Comment": "Exercises each state type",
+  "StartAt": "Choose",
+  "States": {
+    "Choose": {
+      "Type": "Choice",
+      "Choices": [
+        {"Variable": "$.count", "NumericGreaterThan": 10, "Next": "Work"},
+        {"And": [{"Variable": "$.a", "IsPresent": true}, {"Not": {"Variable": "$.b", "StringEquals": "x"}}], "Next": "Wait"}
+      ],
+      "Default": "Done"
+    },
+    "Wait": {"Type": "Wait", "Seconds": 5, "Next": "Work"},
+    "Work": {
+      "Type": "Task",
+      "Resource": "arn:aws:lambda:us-west-2:123456789012:function:example",
+      "Parameters": {"id.$": "States.Format('item-{}', $.id)", "uuid.$": "States.UUID()", "static": "States.NotAFunction"},
+      "Retry": [{"ErrorEquals": ["States.ALL"], "IntervalSeconds": 2, "MaxAttempts": 0, "BackoffRate": 1.5}],
+      "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Failed"}],
+      "Next": "Fan"
+    },
+    "Fan": {
+      "Type": "Parallel",
+      "Branches": [{"StartAt": "One", "States": {"One": {"Type": "Pass", "End": true}}}],
+      "Next": "Each"
+    },
+    "Each": {
+      "Type": "Map",
+      "ItemProcessor": {"StartAt": "Item", "States": {"Item": {"Type": "Succeed"}}},
+      "Next": "Done"
+    },
+    "Failed": {"Type": "Fail", "Error": "Oops"},
+    "Done": {"Type": "Succeed"}
+  }
+}`,
+		},
+		{
+			Name:       "invalid JSON",
+			Definition: `{"StartAt": `,
+			Expected:   []string{"invalid JSON: unexpected EOF"},
+		},
+		{
+			Name:       "missing StartAt state",
+			Definition: `{"StartAt": "Missing", "States": {"A": {"Type": "Succeed"}}}`,
+			Expected:   []string{"StartAt (Missing) does not refer to a state"},
+		},
+		{
+			Name:       "unreachable state",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}, "B": {"Type": "Succeed"}}}`,
+			Expected:   []string{"States.B: state is not reachable from StartAt (A)"},
+		},
+		{
+			Name: "Next and End",
+			Definition: `{"StartAt": "A", "States": {
+  "A": {"Type": "Pass", "Next": "B", "End": true},
+  "B": {"Type": "Pass"},
+  "C": {"Type": "Succeed", "Next": "B"}
+}}`,
+			Expected: []string{
+				"States.A: exactly one of Next or End is required, got both",
+				"States.B: exactly one of Next or End is required",
+				"States.B: state is not reachable from StartAt (A)",
+				"States.C: Succeed states cannot have Next or End",
+				"States.C: state is not reachable from StartAt (A)",
+			},
+		},
+		{
+			Name: "Choice rules",
+			Definition: `{"StartAt": "A", "States": {
+  "A": {"Type": "Choice", "Choices": [
+    {"Variable": "count", "NumericEquals": 1, "Next": "B"},
+    {"Variable": "$.x", "StringEquals": "a", "StringLessThan": "b", "Next": "B"},
+    {"Not": {"Variable": "$.x", "IsNull": true, "Next": "B"}, "Next": "B"}
+  ], "Default": "Missing"},
+  "B": {"Type": "Succeed"}
+}}`,
+			Expected: []string{
+				"States.A: Default (Missing) does not refer to a state",
+				"States.A.Choices[0]: NumericEquals requires Variable to be a path beginning with $",
+				"States.A.Choices[1]: choice rule requires exactly one comparison operator or And, Or or Not, got 2 (StringEquals, StringLessThan)",
+				"States.A.Choices[2].Not: nested choice rules cannot have Next",
+			},
+		},
+		{
+			Name: "Retry and Catch",
+			Definition: `{"StartAt": "A", "States": {
+  "A": {"Type": "Task", "Resource": "arn:aws:states:::sqs:sendMessage", "End": true,
+    "Retry": [{"ErrorEquals": [], "IntervalSeconds": 0, "MaxAttempts": 1.5, "BackoffRate": 0.5}],
+    "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Missing"}]},
+  "B": {"Type": "Pass", "End": true, "Retry": [{"ErrorEquals": ["States.ALL"]}]}
+}}`,
+			Expected: []string{
+				"States.A: Catch[0].Next (Missing) does not refer to a state",
+				"States.A.Retry[0]: ErrorEquals must be a non-empty array",
+				"States.A.Retry[0]: IntervalSeconds must be a number greater than or equal to 1",
+				"States.A.Retry[0]: MaxAttempts must be an integer",
+				"States.A.Retry[0]: BackoffRate must be a number greater than or equal to 1",
+				"States.B: Retry is only allowed in Task, Parallel and Map states",
+				"States.B: state is not reachable from StartAt (A)",
+			},
+		},
+		{
+			Name: "intrinsic functions",
+			Definition: `{"StartAt": "A", "States": {
+  "A": {"Type": "Pass", "End": true, "Parameters": {
+    "a.$": "States.Frobnicate($.x)",
+    "b.$": "States.Format('unterminated, $.x)",
+    "c": {"d.$": "States.Array(States.StringToJson($.x), 1"}
+  }}
+}}`,
+			Expected: []string{
+				"States.A.Parameters.a.$: unknown intrinsic function States.Frobnicate",
+				"States.A.Parameters.b.$: unterminated string literal 'unterminated, $.x)",
+				"States.A.Parameters.c.d.$: unterminated arguments to intrinsic function States.Array",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var got []string
+			for _, err := range validateDefinition(testCase.Definition) {
+				got = append(got, err.Error())
+			}
+
+			if strings.Join(got, "\n") != strings.Join(testCase.Expected, "\n") {
+				t.Errorf("expected errors:\n%s\ngot:\n%s", strings.Join(testCase.Expected, "\n"), strings.Join(got, "\n"))
+			}
+		})
+	}
+}
+
+func TestValidIntrinsicFunction(t *testing.T) {
+	testCases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{Value: "States.UUID()", ErrCount: 0},
+		{Value: "States.Format('{} and {}', $.a, States.ArrayLength($.b))", ErrCount: 0},
+		{Value: `States.Format('it\'s {}', $.a)`, ErrCount: 0},
+		{Value: "States.MathAdd($.a, -1)", ErrCount: 0},
+		{Value: "States.Hash($.a, 'SHA-256') ", ErrCount: 0},
+		{Value: "States.Format", ErrCount: 1},
+		{Value: "States.Format('a',)", ErrCount: 1},
+		{Value: "States.UUID() extra", ErrCount: 1},
+		{Value: "States.uuid()", ErrCount: 1},
+		{Value: "States.", ErrCount: 1},
+	}
+
+	for _, tc := range testCases {
+		err := validIntrinsicFunction(tc.Value)
+
+		if tc.ErrCount == 0 && err != nil {
+			t.Errorf("expected %q not to trigger a validation error, got %s", tc.Value, err)
+		}
+
+		if tc.ErrCount > 0 && err == nil {
+			t.Errorf("expected %q to trigger a validation error", tc.Value)
+		}
+	}
+}
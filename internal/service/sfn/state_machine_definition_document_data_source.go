@@ -0,0 +1,507 @@
+package sfn
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+)
+
+func DataSourceStateMachineDefinitionDocument() *schema.Resource {
+	errorEqualsSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{
+		Read: dataSourceStateMachineDefinitionDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_at": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"state": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"branches": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsJSON,
+							},
+						},
+						"catch": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"error_equals": errorEqualsSchema,
+									"next": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"result_path": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"cause": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"choice": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"comparison": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"next": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"rule_json": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsJSON,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"variable": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"default": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"end": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"heartbeat_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"input_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"items_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"iterator": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"max_concurrency": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 80),
+						},
+						"next": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"output_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"parameters": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"result": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"result_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"result_selector": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"retry": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"backoff_rate": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										Default:      2.0,
+										ValidateFunc: validation.FloatAtLeast(1.0),
+									},
+									"error_equals": errorEqualsSchema,
+									"interval_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"max_attempts": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      3,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+								},
+							},
+						},
+						"seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"seconds_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"timeout_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"timestamp": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+						"timestamp_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(stateType_Values(), false),
+						},
+					},
+				},
+			},
+			"timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "1.0",
+			},
+		},
+	}
+}
+
+func dataSourceStateMachineDefinitionDocumentRead(d *schema.ResourceData, meta interface{}) error {
+	machine := map[string]interface{}{
+		"StartAt": d.Get("start_at").(string),
+		"Version": d.Get("version").(string),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		machine["Comment"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("timeout_seconds"); ok {
+		machine["TimeoutSeconds"] = v.(int)
+	}
+
+	states := make(map[string]interface{})
+
+	for i, tfMapRaw := range d.Get("state").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+
+		if _, ok := states[name]; ok {
+			return fmt.Errorf("duplicate state name (%s) in state %d", name, i)
+		}
+
+		state, err := expandDefinitionState(tfMap)
+
+		if err != nil {
+			return fmt.Errorf("error reading state (%s): %w", name, err)
+		}
+
+		states[name] = state
+	}
+
+	machine["States"] = states
+
+	jsonDoc, err := json.MarshalIndent(machine, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	jsonString := string(jsonDoc)
+
+	if errs := validateDefinition(jsonString); len(errs) > 0 {
+		var msgs []string
+		for _, err := range errs {
+			msgs = append(msgs, err.Error())
+		}
+
+		return fmt.Errorf("invalid state machine definition:\n\t%s", strings.Join(msgs, "\n\t"))
+	}
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return nil
+}
+
+func expandDefinitionState(tfMap map[string]interface{}) (map[string]interface{}, error) {
+	stateType := tfMap["type"].(string)
+	state := map[string]interface{}{
+		"Type": stateType,
+	}
+
+	for key, field := range map[string]string{
+		"cause":          "Cause",
+		"comment":        "Comment",
+		"default":        "Default",
+		"error":          "Error",
+		"input_path":     "InputPath",
+		"items_path":     "ItemsPath",
+		"next":           "Next",
+		"output_path":    "OutputPath",
+		"resource":       "Resource",
+		"result_path":    "ResultPath",
+		"seconds_path":   "SecondsPath",
+		"timestamp":      "Timestamp",
+		"timestamp_path": "TimestampPath",
+	} {
+		if v, ok := tfMap[key].(string); ok && v != "" {
+			state[field] = v
+		}
+	}
+
+	for key, field := range map[string]string{
+		"heartbeat_seconds": "HeartbeatSeconds",
+		"max_concurrency":   "MaxConcurrency",
+		"timeout_seconds":   "TimeoutSeconds",
+	} {
+		if v, ok := tfMap[key].(int); ok && v > 0 {
+			state[field] = v
+		}
+	}
+
+	for key, field := range map[string]string{
+		"iterator":        "Iterator",
+		"parameters":      "Parameters",
+		"result":          "Result",
+		"result_selector": "ResultSelector",
+	} {
+		if v, ok := tfMap[key].(string); ok && v != "" {
+			var value interface{}
+
+			if err := json.Unmarshal([]byte(v), &value); err != nil {
+				return nil, fmt.Errorf("error decoding %s: %w", key, err)
+			}
+
+			state[field] = value
+		}
+	}
+
+	if v, ok := tfMap["end"].(bool); ok && v {
+		state["End"] = true
+	}
+
+	// A Wait state with no other wait field waits for "seconds", which may be zero.
+	if stateType == stateTypeWait && state["SecondsPath"] == nil && state["Timestamp"] == nil && state["TimestampPath"] == nil {
+		state["Seconds"] = tfMap["seconds"].(int)
+	}
+
+	if v, ok := tfMap["branches"].([]interface{}); ok && len(v) > 0 {
+		var branches []interface{}
+
+		for i, b := range v {
+			var branch interface{}
+
+			if err := json.Unmarshal([]byte(b.(string)), &branch); err != nil {
+				return nil, fmt.Errorf("error decoding branches[%d]: %w", i, err)
+			}
+
+			branches = append(branches, branch)
+		}
+
+		state["Branches"] = branches
+	}
+
+	if v, ok := tfMap["choice"].([]interface{}); ok && len(v) > 0 {
+		var choices []interface{}
+
+		for i, c := range v {
+			choice, err := expandDefinitionChoiceRule(c.(map[string]interface{}))
+
+			if err != nil {
+				return nil, fmt.Errorf("error reading choice %d: %w", i, err)
+			}
+
+			choices = append(choices, choice)
+		}
+
+		state["Choices"] = choices
+	}
+
+	if v, ok := tfMap["retry"].([]interface{}); ok && len(v) > 0 {
+		var retriers []interface{}
+
+		for _, r := range v {
+			r := r.(map[string]interface{})
+
+			retriers = append(retriers, map[string]interface{}{
+				"BackoffRate":     r["backoff_rate"].(float64),
+				"ErrorEquals":     r["error_equals"].([]interface{}),
+				"IntervalSeconds": r["interval_seconds"].(int),
+				"MaxAttempts":     r["max_attempts"].(int),
+			})
+		}
+
+		state["Retry"] = retriers
+	}
+
+	if v, ok := tfMap["catch"].([]interface{}); ok && len(v) > 0 {
+		var catchers []interface{}
+
+		for _, c := range v {
+			c := c.(map[string]interface{})
+
+			catcher := map[string]interface{}{
+				"ErrorEquals": c["error_equals"].([]interface{}),
+				"Next":        c["next"].(string),
+			}
+
+			if v, ok := c["result_path"].(string); ok && v != "" {
+				catcher["ResultPath"] = v
+			}
+
+			catchers = append(catchers, catcher)
+		}
+
+		state["Catch"] = catchers
+	}
+
+	return state, nil
+}
+
+// expandDefinitionChoiceRule returns a top-level choice rule, either decoded from rule_json
+// or built from a single comparison.
+func expandDefinitionChoiceRule(tfMap map[string]interface{}) (map[string]interface{}, error) {
+	rule := map[string]interface{}{}
+
+	if v, ok := tfMap["rule_json"].(string); ok && v != "" {
+		if err := json.Unmarshal([]byte(v), &rule); err != nil {
+			return nil, fmt.Errorf("error decoding rule_json: %w", err)
+		}
+	} else {
+		variable, _ := tfMap["variable"].(string)
+		comparison, _ := tfMap["comparison"].(string)
+
+		if variable == "" || comparison == "" {
+			return nil, fmt.Errorf("one of rule_json or both variable and comparison are required")
+		}
+
+		if _, ok := choiceComparisonOperators[comparison]; !ok {
+			return nil, fmt.Errorf("unknown comparison operator (%s)", comparison)
+		}
+
+		value, err := choiceComparisonValue(comparison, tfMap["value"].(string))
+
+		if err != nil {
+			return nil, err
+		}
+
+		rule["Variable"] = variable
+		rule[comparison] = value
+	}
+
+	rule["Next"] = tfMap["next"].(string)
+
+	return rule, nil
+}
+
+// choiceComparisonValue converts a comparison value to the JSON type expected by the operator.
+func choiceComparisonValue(comparison, value string) (interface{}, error) {
+	switch {
+	case strings.HasSuffix(comparison, "Path"):
+		return value, nil
+	case strings.HasPrefix(comparison, "Numeric"):
+		f, err := strconv.ParseFloat(value, 64)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s requires a numeric value, got %q", comparison, value)
+		}
+
+		return f, nil
+	case comparison == "BooleanEquals" || strings.HasPrefix(comparison, "Is"):
+		b, err := strconv.ParseBool(value)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s requires a boolean value, got %q", comparison, value)
+		}
+
+		return b, nil
+	default:
+		return value, nil
+	}
+}
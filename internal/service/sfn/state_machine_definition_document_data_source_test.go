@@ -0,0 +1,156 @@
+package sfn_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSFNStateMachineDefinitionDocumentDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_sfn_state_machine_definition_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, sfn.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDefinitionDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccStateMachineDefinitionDocumentDataSourceExpectedJSON_basic),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDocumentDataSource_invalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, sfn.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineDefinitionDocumentDataSourceConfig_unreachable,
+				ExpectError: regexp.MustCompile(`States.Orphan: state is not reachable from StartAt \(Start\)`),
+			},
+		},
+	})
+}
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_basic = `
+data "aws_sfn_state_machine_definition_document" "test" {
+  comment  = "Checks a value"
+  start_at = "Check"
+
+  state {
+    name    = "Check"
+    type    = "Choice"
+    default = "Wait"
+
+    choice {
+      variable   = "$.count"
+      comparison = "NumericGreaterThan"
+      value      = "10"
+      next       = "Done"
+    }
+
+    choice {
+      rule_json = jsonencode({
+        Not = {
+          Variable      = "$.enabled"
+          BooleanEquals = true
+        }
+      })
+      next = "Failed"
+    }
+  }
+
+  state {
+    name    = "Wait"
+    type    = "Wait"
+    seconds = 0
+    next    = "Work"
+  }
+
+  state {
+    name     = "Work"
+    type     = "Task"
+    resource = "arn:aws:states:::lambda:invoke"
+    end      = true
+
+    parameters = jsonencode({
+      "Payload.$" = "States.Format('item-{}', $.id)"
+    })
+
+    retry {
+      error_equals = ["States.TaskFailed"]
+      max_attempts = 2
+    }
+
+    catch {
+      error_equals = ["States.ALL"]
+      next         = "Failed"
+      result_path  = "$.error"
+    }
+  }
+
+  state {
+    name  = "Failed"
+    type  = "Fail"
+    error = "CheckFailed"
+  }
+
+  state {
+    name = "Done"
+    type = "Succeed"
+  }
+}
+`
+
+var testAccStateMachineDefinitionDocumentDataSourceExpectedJSON_basic = `{
+  "Comment": "Checks a value",
+  "StartAt": "Check",
+  "Version": "1.0",
+  "States": {
+    "Check": {
+      "Type": "Choice",
+      "Choices": [
+        {"Variable": "$.count", "NumericGreaterThan": 10, "Next": "Done"},
+        {"Not": {"Variable": "$.enabled", "BooleanEquals": true}, "Next": "Failed"}
+      ],
+      "Default": "Wait"
+    },
+    "Wait": {"Type": "Wait", "Seconds": 0, "Next": "Work"},
+    "Work": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Parameters": {"Payload.$": "States.Format('item-{}', $.id)"},
+      "Retry": [{"ErrorEquals": ["States.TaskFailed"], "IntervalSeconds": 1, "MaxAttempts": 2, "BackoffRate": 2}],
+      "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Failed", "ResultPath": "$.error"}],
+      "End": true
+    },
+    "Failed": {"Type": "Fail", "Error": "CheckFailed"},
+    "Done": {"Type": "Succeed"}
+  }
+}`
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_unreachable = `
+data "aws_sfn_state_machine_definition_document" "test" {
+  start_at = "Start"
+
+  state {
+    name = "Start"
+    type = "Succeed"
+  }
+
+  state {
+    name = "Orphan"
+    type = "Pass"
+    end  = true
+  }
+}
+`
@@ -0,0 +1,209 @@
+package ssoadmin
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCustomerManagedPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCustomerManagedPolicyAttachmentCreate,
+		Read:   resourceCustomerManagedPolicyAttachmentRead,
+		Delete: resourceCustomerManagedPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"customer_managed_policy_reference": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: customerManagedPolicyReferenceSchema(true),
+				},
+			},
+
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"permission_set_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func customerManagedPolicyReferenceSchema(forceNew bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     forceNew,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		},
+
+		"path": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     forceNew,
+			Default:      "/",
+			ValidateFunc: validation.StringLenBetween(1, 512),
+		},
+	}
+}
+
+func resourceCustomerManagedPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	instanceArn := d.Get("instance_arn").(string)
+	permissionSetArn := d.Get("permission_set_arn").(string)
+	policyReference := expandCustomerManagedPolicyReference(d.Get("customer_managed_policy_reference").([]interface{})[0].(map[string]interface{}))
+	policyName := aws.StringValue(policyReference.Name)
+	policyPath := aws.StringValue(policyReference.Path)
+
+	input := &ssoadmin.AttachCustomerManagedPolicyReferenceToPermissionSetInput{
+		CustomerManagedPolicyReference: policyReference,
+		InstanceArn:                    aws.String(instanceArn),
+		PermissionSetArn:               aws.String(permissionSetArn),
+	}
+
+	_, err := conn.AttachCustomerManagedPolicyReferenceToPermissionSet(input)
+
+	if err != nil {
+		return fmt.Errorf("error attaching Customer Managed Policy (%s%s) to SSO Permission Set (%s): %w", policyPath, policyName, permissionSetArn, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s,%s,%s", policyName, policyPath, permissionSetArn, instanceArn))
+
+	// Provision ALL accounts after attaching the customer managed policy
+	if err := provisionSsoAdminPermissionSet(conn, permissionSetArn, instanceArn); err != nil {
+		return err
+	}
+
+	return resourceCustomerManagedPolicyAttachmentRead(d, meta)
+}
+
+func resourceCustomerManagedPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	policyName, policyPath, permissionSetArn, instanceArn, err := ParseCustomerManagedPolicyAttachmentID(d.Id())
+	if err != nil {
+		return fmt.Errorf("error parsing SSO Customer Managed Policy Attachment ID: %w", err)
+	}
+
+	policy, err := FindCustomerManagedPolicy(conn, policyName, policyPath, permissionSetArn, instanceArn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Customer Managed Policy (%s%s) for SSO Permission Set (%s) not found, removing from state", policyPath, policyName, permissionSetArn)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Customer Managed Policy (%s%s) for SSO Permission Set (%s): %w", policyPath, policyName, permissionSetArn, err)
+	}
+
+	if err := d.Set("customer_managed_policy_reference", []interface{}{flattenCustomerManagedPolicyReference(policy)}); err != nil {
+		return fmt.Errorf("error setting customer_managed_policy_reference: %w", err)
+	}
+	d.Set("instance_arn", instanceArn)
+	d.Set("permission_set_arn", permissionSetArn)
+
+	return nil
+}
+
+func resourceCustomerManagedPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	policyName, policyPath, permissionSetArn, instanceArn, err := ParseCustomerManagedPolicyAttachmentID(d.Id())
+	if err != nil {
+		return fmt.Errorf("error parsing SSO Customer Managed Policy Attachment ID: %w", err)
+	}
+
+	input := &ssoadmin.DetachCustomerManagedPolicyReferenceFromPermissionSetInput{
+		CustomerManagedPolicyReference: &ssoadmin.CustomerManagedPolicyReference{
+			Name: aws.String(policyName),
+			Path: aws.String(policyPath),
+		},
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	}
+
+	_, err = conn.DetachCustomerManagedPolicyReferenceFromPermissionSet(input)
+
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+		return fmt.Errorf("error detaching Customer Managed Policy (%s%s) from SSO Permission Set (%s): %w", policyPath, policyName, permissionSetArn, err)
+	}
+
+	// Provision ALL accounts after detaching the customer managed policy
+	if err := provisionSsoAdminPermissionSet(conn, permissionSetArn, instanceArn); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func ParseCustomerManagedPolicyAttachmentID(id string) (string, string, string, string, error) {
+	idParts := strings.Split(id, ",")
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		return "", "", "", "", fmt.Errorf("error parsing ID: expected POLICY_NAME,POLICY_PATH,PERMISSION_SET_ARN,INSTANCE_ARN")
+	}
+	return idParts[0], idParts[1], idParts[2], idParts[3], nil
+}
+
+func expandCustomerManagedPolicyReference(tfMap map[string]interface{}) *ssoadmin.CustomerManagedPolicyReference {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ssoadmin.CustomerManagedPolicyReference{}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["path"].(string); ok && v != "" {
+		apiObject.Path = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenCustomerManagedPolicyReference(apiObject *ssoadmin.CustomerManagedPolicyReference) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Path; v != nil {
+		tfMap["path"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
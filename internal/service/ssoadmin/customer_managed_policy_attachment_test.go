@@ -0,0 +1,201 @@
+package ssoadmin_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSOAdminCustomerManagedPolicyAttachment_basic(t *testing.T) {
+	resourceName := "aws_ssoadmin_customer_managed_policy_attachment.test"
+	permissionSetResourceName := "aws_ssoadmin_permission_set.test"
+	policyResourceName := "aws_iam_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomerManagedPolicyAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomerManagedPolicyAttachmentBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomerManagedPolicyAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "customer_managed_policy_reference.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "customer_managed_policy_reference.0.name", policyResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "customer_managed_policy_reference.0.path", "/"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_arn", permissionSetResourceName, "instance_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "permission_set_arn", permissionSetResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminCustomerManagedPolicyAttachment_forceNew(t *testing.T) {
+	resourceName := "aws_ssoadmin_customer_managed_policy_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomerManagedPolicyAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomerManagedPolicyAttachmentBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomerManagedPolicyAttachmentExists(resourceName),
+				),
+			},
+			{
+				Config: testAccCustomerManagedPolicyAttachmentPathConfig(rName, "/test/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomerManagedPolicyAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "customer_managed_policy_reference.0.path", "/test/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminCustomerManagedPolicyAttachment_disappears(t *testing.T) {
+	resourceName := "aws_ssoadmin_customer_managed_policy_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomerManagedPolicyAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomerManagedPolicyAttachmentBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomerManagedPolicyAttachmentExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssoadmin.ResourceCustomerManagedPolicyAttachment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCustomerManagedPolicyAttachmentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssoadmin_customer_managed_policy_attachment" {
+			continue
+		}
+
+		policyName, policyPath, permissionSetArn, instanceArn, err := tfssoadmin.ParseCustomerManagedPolicyAttachmentID(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error parsing SSO Customer Managed Policy Attachment ID (%s): %w", rs.Primary.ID, err)
+		}
+
+		_, err = tfssoadmin.FindCustomerManagedPolicy(conn, policyName, policyPath, permissionSetArn, instanceArn)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Customer Managed Policy (%s%s) for SSO Permission Set (%s) still exists", policyPath, policyName, permissionSetArn)
+	}
+
+	return nil
+}
+
+func testAccCheckCustomerManagedPolicyAttachmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource (%s) ID not set", resourceName)
+		}
+
+		policyName, policyPath, permissionSetArn, instanceArn, err := tfssoadmin.ParseCustomerManagedPolicyAttachmentID(rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error parsing SSO Customer Managed Policy Attachment ID (%s): %w", rs.Primary.ID, err)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn
+
+		_, err = tfssoadmin.FindCustomerManagedPolicy(conn, policyName, policyPath, permissionSetArn, instanceArn)
+
+		return err
+	}
+}
+
+func testAccCustomerManagedPolicyAttachmentBaseConfig(rName, path string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  name         = %[1]q
+  instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+
+resource "aws_iam_policy" "test" {
+  name        = %[1]q
+  path        = %[2]q
+  description = "Customer managed policy attached to an SSO permission set"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["ec2:Describe*"]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName, path)
+}
+
+func testAccCustomerManagedPolicyAttachmentBasicConfig(rName string) string {
+	return testAccCustomerManagedPolicyAttachmentPathConfig(rName, "/")
+}
+
+func testAccCustomerManagedPolicyAttachmentPathConfig(rName, path string) string {
+	return acctest.ConfigCompose(
+		testAccCustomerManagedPolicyAttachmentBaseConfig(rName, path),
+		`
+resource "aws_ssoadmin_customer_managed_policy_attachment" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+
+  customer_managed_policy_reference {
+    name = aws_iam_policy.test.name
+    path = aws_iam_policy.test.path
+  }
+}
+`)
+}
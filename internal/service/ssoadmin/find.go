@@ -3,6 +3,9 @@ package ssoadmin
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // FindAccountAssignment returns the account assigned to a permission set within a specified SSO instance.
@@ -69,3 +72,78 @@ func FindManagedPolicy(conn *ssoadmin.SSOAdmin, managedPolicyArn, permissionSetA
 
 	return attachedPolicy, err
 }
+
+// FindCustomerManagedPolicy returns the customer managed policy reference attached to a permission set within a specified SSO instance.
+// Returns an error if no customer managed policy reference is found.
+func FindCustomerManagedPolicy(conn *ssoadmin.SSOAdmin, policyName, policyPath, permissionSetArn, instanceArn string) (*ssoadmin.CustomerManagedPolicyReference, error) {
+	input := &ssoadmin.ListCustomerManagedPolicyReferencesInPermissionSetInput{
+		PermissionSetArn: aws.String(permissionSetArn),
+		InstanceArn:      aws.String(instanceArn),
+	}
+
+	var policyReference *ssoadmin.CustomerManagedPolicyReference
+	err := conn.ListCustomerManagedPolicyReferencesInPermissionSetPages(input, func(page *ssoadmin.ListCustomerManagedPolicyReferencesInPermissionSetOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, policy := range page.CustomerManagedPolicyReferences {
+			if policy == nil {
+				continue
+			}
+
+			if aws.StringValue(policy.Name) == policyName && aws.StringValue(policy.Path) == policyPath {
+				policyReference = policy
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if policyReference == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "customer managed policy reference not found",
+			LastRequest: input,
+		}
+	}
+
+	return policyReference, nil
+}
+
+// FindPermissionsBoundary returns the permissions boundary attached to a permission set within a specified SSO instance.
+func FindPermissionsBoundary(conn *ssoadmin.SSOAdmin, permissionSetArn, instanceArn string) (*ssoadmin.PermissionsBoundary, error) {
+	input := &ssoadmin.GetPermissionsBoundaryForPermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	}
+
+	output, err := conn.GetPermissionsBoundaryForPermissionSet(input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PermissionsBoundary == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.PermissionsBoundary, nil
+}
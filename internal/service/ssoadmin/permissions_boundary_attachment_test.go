@@ -0,0 +1,221 @@
+package ssoadmin_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSOAdminPermissionsBoundaryAttachment_basic(t *testing.T) {
+	resourceName := "aws_ssoadmin_permissions_boundary_attachment.test"
+	permissionSetResourceName := "aws_ssoadmin_permission_set.test"
+	policyResourceName := "aws_iam_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPermissionsBoundaryAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsBoundaryAttachmentCustomerManagedPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsBoundaryAttachmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_arn", permissionSetResourceName, "instance_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "permission_set_arn", permissionSetResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "permissions_boundary.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "permissions_boundary.0.customer_managed_policy_reference.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "permissions_boundary.0.customer_managed_policy_reference.0.name", policyResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "permissions_boundary.0.customer_managed_policy_reference.0.path", "/"),
+					resource.TestCheckResourceAttr(resourceName, "permissions_boundary.0.managed_policy_arn", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminPermissionsBoundaryAttachment_update(t *testing.T) {
+	resourceName := "aws_ssoadmin_permissions_boundary_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPermissionsBoundaryAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsBoundaryAttachmentCustomerManagedPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsBoundaryAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "permissions_boundary.0.customer_managed_policy_reference.#", "1"),
+				),
+			},
+			{
+				Config: testAccPermissionsBoundaryAttachmentManagedPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsBoundaryAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "permissions_boundary.0.customer_managed_policy_reference.#", "0"),
+					//lintignore:AWSAT001
+					resource.TestMatchResourceAttr(resourceName, "permissions_boundary.0.managed_policy_arn", regexp.MustCompile(`policy/ReadOnlyAccess`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminPermissionsBoundaryAttachment_disappears(t *testing.T) {
+	resourceName := "aws_ssoadmin_permissions_boundary_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPermissionsBoundaryAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsBoundaryAttachmentManagedPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsBoundaryAttachmentExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssoadmin.ResourcePermissionsBoundaryAttachment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPermissionsBoundaryAttachmentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssoadmin_permissions_boundary_attachment" {
+			continue
+		}
+
+		permissionSetArn, instanceArn, err := tfssoadmin.ParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error parsing SSO Permissions Boundary Attachment ID (%s): %w", rs.Primary.ID, err)
+		}
+
+		_, err = tfssoadmin.FindPermissionsBoundary(conn, permissionSetArn, instanceArn)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Permissions Boundary for SSO Permission Set (%s) still exists", permissionSetArn)
+	}
+
+	return nil
+}
+
+func testAccCheckPermissionsBoundaryAttachmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource (%s) ID not set", resourceName)
+		}
+
+		permissionSetArn, instanceArn, err := tfssoadmin.ParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error parsing SSO Permissions Boundary Attachment ID (%s): %w", rs.Primary.ID, err)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn
+
+		_, err = tfssoadmin.FindPermissionsBoundary(conn, permissionSetArn, instanceArn)
+
+		return err
+	}
+}
+
+func testAccPermissionsBoundaryAttachmentBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  name         = %[1]q
+  instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+
+resource "aws_iam_policy" "test" {
+  name        = %[1]q
+  description = "Permissions boundary for an SSO permission set"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["ec2:Describe*"]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccPermissionsBoundaryAttachmentCustomerManagedPolicyConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPermissionsBoundaryAttachmentBaseConfig(rName),
+		`
+resource "aws_ssoadmin_permissions_boundary_attachment" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+
+  permissions_boundary {
+    customer_managed_policy_reference {
+      name = aws_iam_policy.test.name
+      path = "/"
+    }
+  }
+}
+`)
+}
+
+func testAccPermissionsBoundaryAttachmentManagedPolicyConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPermissionsBoundaryAttachmentBaseConfig(rName),
+		`
+resource "aws_ssoadmin_permissions_boundary_attachment" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+
+  permissions_boundary {
+    managed_policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/ReadOnlyAccess"
+  }
+}
+`)
+}
@@ -198,7 +198,7 @@ func RetryConfigContext(ctx context.Context, delay time.Duration, delayRand time
 		c.PollInterval = pollInterval
 	}
 
-	_, waitErr := c.WaitForStateContext(ctx)
+	_, waitErr := WaitForStateContext(ctx, c)
 
 	// Need to acquire the lock here to be able to avoid race using resultErr as
 	// the return value
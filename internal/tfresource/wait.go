@@ -2,6 +2,7 @@ package tfresource
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -14,6 +15,11 @@ type WaitOpts struct {
 	PollInterval              time.Duration // Override MinTimeout/backoff and only poll this often.
 }
 
+var waitOptsOverride struct {
+	sync.RWMutex
+	opts *WaitOpts
+}
+
 const (
 	targetStateError = "ERROR"
 	targetStateFalse = "FALSE"
@@ -50,7 +56,7 @@ func WaitUntilContext(ctx context.Context, timeout time.Duration, f func() (bool
 		PollInterval:              opts.PollInterval,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -62,3 +68,33 @@ func WaitUntilContext(ctx context.Context, timeout time.Duration, f func() (bool
 func WaitUntil(timeout time.Duration, f func() (bool, error), opts WaitOpts) error {
 	return WaitUntilContext(context.Background(), timeout, f, opts)
 }
+
+// SetWaitOptsOverride overrides the Delay, MinTimeout and PollInterval of the waiters run by
+// WaitForState and WaitForStateContext, or removes the override if opts is nil.
+// Unit tests use it to run waiters against fake AWS clients without waiting.
+func SetWaitOptsOverride(opts *WaitOpts) {
+	waitOptsOverride.Lock()
+	defer waitOptsOverride.Unlock()
+
+	waitOptsOverride.opts = opts
+}
+
+// WaitForStateContext waits for the state of the StateChangeConf to reach its target, as
+// conf.WaitForStateContext does, with the timing set by SetWaitOptsOverride, if any.
+func WaitForStateContext(ctx context.Context, conf *resource.StateChangeConf) (interface{}, error) {
+	waitOptsOverride.RLock()
+	if opts := waitOptsOverride.opts; opts != nil {
+		conf.Delay = opts.Delay
+		conf.MinTimeout = opts.MinTimeout
+		conf.PollInterval = opts.PollInterval
+	}
+	waitOptsOverride.RUnlock()
+
+	return conf.WaitForStateContext(ctx)
+}
+
+// WaitForState waits for the state of the StateChangeConf to reach its target, as
+// conf.WaitForState does, with the timing set by SetWaitOptsOverride, if any.
+func WaitForState(conf *resource.StateChangeConf) (interface{}, error) {
+	return WaitForStateContext(context.Background(), conf)
+}
@@ -1,11 +1,13 @@
 package tfresource_test
 
 import (
+	"context"
 	"errors"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -63,3 +65,39 @@ func TestWaitUntil(t *testing.T) {
 		})
 	}
 }
+
+func TestWaitForStateContextOverride(t *testing.T) {
+	tfresource.SetWaitOptsOverride(&tfresource.WaitOpts{PollInterval: time.Millisecond})
+	defer tfresource.SetWaitOptsOverride(nil)
+
+	var refreshCount int32
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"done"},
+		Timeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			if atomic.AddInt32(&refreshCount, 1) < 5 {
+				return "", "pending", nil
+			}
+
+			return "", "done", nil
+		},
+		Delay:      time.Minute,
+		MinTimeout: time.Minute,
+	}
+
+	start := time.Now()
+
+	if _, err := tfresource.WaitForStateContext(context.Background(), stateConf); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected the Delay and MinTimeout to be overridden, waited %s", elapsed)
+	}
+
+	if got, expected := atomic.LoadInt32(&refreshCount), int32(5); got != expected {
+		t.Errorf("expected %d refreshes, got %d", expected, got)
+	}
+}
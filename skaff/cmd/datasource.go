@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/datasource"
+	"github.com/spf13/cobra"
+)
+
+var datasourceCmd = &cobra.Command{
+	Use:   "datasource",
+	Short: "Create scaffolding for a data source",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return datasource.Create(name, snakeName, false, !clearComments, force)
+	},
+}
+
+var listDatasourceCmd = &cobra.Command{
+	Use:   "listdatasource",
+	Short: "Create scaffolding for a plural data source listing the objects of a type",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return datasource.Create(name, snakeName, true, !clearComments, force)
+	},
+}
+
+func init() {
+	for _, c := range []*cobra.Command{datasourceCmd, listDatasourceCmd} {
+		rootCmd.AddCommand(c)
+		c.Flags().StringVarP(&snakeName, "snakename", "s", "", "If skaff doesn't get it right, explicitly give name in snake case (e.g., db_vpc_instance)")
+		c.Flags().BoolVarP(&clearComments, "clear-comments", "c", false, "Do not include instructional comments in source")
+		c.Flags().StringVarP(&name, "name", "n", "", "Name of the entity")
+		c.Flags().BoolVarP(&force, "force", "f", false, "Force creation, overwriting existing files")
+	}
+}
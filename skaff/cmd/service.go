@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/service"
+	"github.com/spf13/cobra"
+)
+
+var tagsSlice bool
+
+var serviceCmd = &cobra.Command{
+	Use:   "service",
+	Short: "Create scaffolding for a service package",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return service.Create(name, tagsSlice, !clearComments, force)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(serviceCmd)
+	serviceCmd.Flags().StringVarP(&name, "name", "n", "", "Name of the service package, as listed in names/names_data.csv (e.g., mq)")
+	serviceCmd.Flags().BoolVarP(&tagsSlice, "tags-slice", "t", false, "Generate tags for a service using a slice of key/value tags instead of a map")
+	serviceCmd.Flags().BoolVarP(&clearComments, "clear-comments", "c", false, "Do not include instructional comments in source")
+	serviceCmd.Flags().BoolVarP(&force, "force", "f", false, "Force creation, overwriting existing files")
+}
@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/tag"
+	"github.com/spf13/cobra"
+)
+
+var idAttribName string
+
+var tagCmd = &cobra.Command{
+	Use:   "tag",
+	Short: "Create scaffolding for a resource managing an individual resource tag",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return tag.Create(idAttribName, !clearComments, force)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(tagCmd)
+	tagCmd.Flags().StringVarP(&idAttribName, "id-attrib-name", "i", tag.DefaultIDAttribName, "Name of the argument identifying the tagged resource (e.g., resource_id)")
+	tagCmd.Flags().BoolVarP(&clearComments, "clear-comments", "c", false, "Do not include instructional comments in source")
+	tagCmd.Flags().BoolVarP(&force, "force", "f", false, "Force creation, overwriting existing files")
+}
@@ -0,0 +1,110 @@
+package datasource
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/scaffold"
+)
+
+//go:embed datasource.tmpl
+var datasourceTmpl string
+
+//go:embed datasourcetest.tmpl
+var datasourceTestTmpl string
+
+//go:embed websitedoc.tmpl
+var websiteTmpl string
+
+type TemplateData struct {
+	DataSource      string
+	DataSourceSnake string
+	ResourceSnake   string
+	HumanName       string
+	IncludeComments bool
+	Plural          bool
+	ServicePackage  string
+	Service         string
+	ServiceLower    string
+	AWSServiceName  string
+}
+
+// Create writes the scaffolding of a data source, or of a plural data source listing
+// the objects of a type, to the service package in the working directory.
+func Create(dsName, snakeName string, plural, comments, force bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if err := scaffold.CheckName(dsName, snakeName); err != nil {
+		return err
+	}
+
+	sd, err := scaffold.NewServiceData(servicePackage)
+	if err != nil {
+		return err
+	}
+
+	templateData := NewTemplateData(dsName, snakeName, plural, comments, sd)
+
+	f := fmt.Sprintf("%s_data_source.go", templateData.DataSourceSnake)
+	if err = scaffold.WriteTemplate("newds", f, datasourceTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing data source template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_data_source_test.go", templateData.DataSourceSnake)
+	if err = scaffold.WriteTemplate("dstest", tf, datasourceTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing data source test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, templateData.DataSourceSnake)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "d", wf)
+	if err = scaffold.WriteTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing data source website doc template: %w", err)
+	}
+
+	return nil
+}
+
+// NewTemplateData returns the names used by the data source templates.
+// The resource of a plural data source is named by the singular of the last word, e.g. broker for Brokers.
+func NewTemplateData(dsName, snakeName string, plural, comments bool, sd scaffold.ServiceData) TemplateData {
+	dsSnake := scaffold.ToSnakeCase(dsName, snakeName)
+
+	resSnake := dsSnake
+	if plural {
+		resSnake = singular(dsSnake)
+	}
+
+	return TemplateData{
+		DataSource:      dsName,
+		DataSourceSnake: dsSnake,
+		ResourceSnake:   resSnake,
+		HumanName:       fmt.Sprintf("%s %s", sd.Service, dsName),
+		IncludeComments: comments,
+		Plural:          plural,
+		ServicePackage:  sd.ServicePackage,
+		Service:         sd.Service,
+		ServiceLower:    sd.ServiceLower,
+		AWSServiceName:  sd.AWSServiceName,
+	}
+}
+
+func singular(s string) string {
+	switch {
+	case strings.HasSuffix(s, "ies"):
+		return strings.TrimSuffix(s, "ies") + "y"
+	case strings.HasSuffix(s, "sses"), strings.HasSuffix(s, "xes"):
+		return strings.TrimSuffix(s, "es")
+	case strings.HasSuffix(s, "s") && !strings.HasSuffix(s, "ss"):
+		return strings.TrimSuffix(s, "s")
+	}
+
+	return s
+}
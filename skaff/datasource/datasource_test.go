@@ -0,0 +1,91 @@
+package datasource
+
+import (
+	"go/format"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/scaffold"
+)
+
+func TestSingular(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected string
+	}{
+		{Input: "brokers", Expected: "broker"},
+		{Input: "db_proxies", Expected: "db_proxy"},
+		{Input: "addresses", Expected: "address"},
+		{Input: "access", Expected: "access"},
+		{Input: "broker", Expected: "broker"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Input, func(t *testing.T) {
+			if got := singular(testCase.Input); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestTemplates(t *testing.T) {
+	sd, err := scaffold.NewServiceData("mq")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, plural := range []bool{false, true} {
+		for _, comments := range []bool{false, true} {
+			name := "Broker"
+			if plural {
+				name = "Brokers"
+			}
+			td := NewTemplateData(name, "", plural, comments, sd)
+			dir := t.TempDir()
+
+			for _, tmpl := range []struct {
+				Name   string
+				Text   string
+				GoFile bool
+			}{
+				{Name: "datasource", Text: datasourceTmpl, GoFile: true},
+				{Name: "datasourcetest", Text: datasourceTestTmpl, GoFile: true},
+				{Name: "websitedoc", Text: websiteTmpl},
+			} {
+				filename := filepath.Join(dir, tmpl.Name)
+
+				if err := scaffold.WriteTemplate(tmpl.Name, filename, tmpl.Text, false, td); err != nil {
+					t.Fatalf("%s (plural: %t, comments: %t): %s", tmpl.Name, plural, comments, err)
+				}
+
+				b, err := os.ReadFile(filename)
+
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !strings.Contains(string(b), "aws_mq_"+td.DataSourceSnake) && tmpl.Name != "datasource" {
+					t.Errorf("%s (plural: %t, comments: %t): expected aws_mq_%s", tmpl.Name, plural, comments, td.DataSourceSnake)
+				}
+
+				if !tmpl.GoFile {
+					continue
+				}
+
+				formatted, err := format.Source(b)
+
+				if err != nil {
+					t.Errorf("%s (plural: %t, comments: %t): formatting: %s\n%s", tmpl.Name, plural, comments, err, b)
+				}
+
+				if string(formatted) != string(b) {
+					t.Errorf("%s (plural: %t, comments: %t): not formatted:\n%s", tmpl.Name, plural, comments, b)
+				}
+			}
+		}
+	}
+}
@@ -1,16 +1,13 @@
 package resource
 
 import (
-	"bytes"
 	_ "embed"
 	"fmt"
 	"os"
 	"path/filepath"
-	"regexp"
 	"strings"
-	"text/template"
 
-	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/scaffold"
 )
 
 //go:embed resource.tmpl
@@ -32,18 +29,6 @@ type TemplateData struct {
 	AWSServiceName  string
 }
 
-func toSnakeCase(upper string, snakeName string) string {
-	if snakeName != "" {
-		return snakeName
-	}
-
-	re := regexp.MustCompile(`([a-z])([A-Z]{2,})`)
-	upper = re.ReplaceAllString(upper, `${1}_${2}`)
-
-	re2 := regexp.MustCompile(`([A-Z][a-z])`)
-	return strings.TrimPrefix(strings.ToLower(re2.ReplaceAllString(upper, `_$1`)), "_")
-}
-
 func Create(resName, snakeName string, comments, force bool) error {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
@@ -52,92 +37,40 @@ func Create(resName, snakeName string, comments, force bool) error {
 
 	servicePackage := filepath.Base(wd)
 
-	if resName == "" {
-		return fmt.Errorf("error checking: no name given")
-	}
-
-	if resName == strings.ToLower(resName) {
-		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
-	}
-
-	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
-		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
-	}
-
-	s, err := names.ServiceProviderNameUpper(servicePackage)
-	if err != nil {
-		return fmt.Errorf("error getting service connection name: %w", err)
+	if err := scaffold.CheckName(resName, snakeName); err != nil {
+		return err
 	}
 
-	sn, err := names.AWSServiceName(servicePackage)
+	sd, err := scaffold.NewServiceData(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting AWS service name: %w", err)
+		return err
 	}
 
 	templateData := TemplateData{
 		Resource:        resName,
 		ResourceLower:   strings.ToLower(resName),
 		IncludeComments: comments,
-		ServicePackage:  servicePackage,
-		Service:         s,
-		ServiceLower:    strings.ToLower(s),
-		AWSServiceName:  sn,
+		ServicePackage:  sd.ServicePackage,
+		Service:         sd.Service,
+		ServiceLower:    sd.ServiceLower,
+		AWSServiceName:  sd.AWSServiceName,
 	}
 
-	f := fmt.Sprintf("%s.go", toSnakeCase(resName, snakeName))
-	if err = writeTemplate("newres", f, resourceTmpl, force, templateData); err != nil {
+	f := fmt.Sprintf("%s.go", scaffold.ToSnakeCase(resName, snakeName))
+	if err = scaffold.WriteTemplate("newres", f, resourceTmpl, force, templateData); err != nil {
 		return fmt.Errorf("writing resource template: %w", err)
 	}
 
-	tf := fmt.Sprintf("%s_test.go", toSnakeCase(resName, snakeName))
-	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
+	tf := fmt.Sprintf("%s_test.go", scaffold.ToSnakeCase(resName, snakeName))
+	if err = scaffold.WriteTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
 		return fmt.Errorf("writing resource test template: %w", err)
 	}
 
-	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, toSnakeCase(resName, snakeName))
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, scaffold.ToSnakeCase(resName, snakeName))
 	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
-	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+	if err = scaffold.WriteTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
 		return fmt.Errorf("writing resource website doc template: %w", err)
 	}
 
 	return nil
 }
-
-func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
-	if _, err := os.Stat(filename); !os.IsNotExist(err) && !force {
-		return fmt.Errorf("file (%s) already exists and force is not set", filename)
-	}
-
-	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
-	if err != nil {
-		return fmt.Errorf("error opening file (%s): %s", filename, err)
-	}
-
-	tplate, err := template.New(templateName).Parse(tmpl)
-	if err != nil {
-		return fmt.Errorf("error parsing template: %s", err)
-	}
-
-	var buffer bytes.Buffer
-	err = tplate.Execute(&buffer, td)
-	if err != nil {
-		return fmt.Errorf("error executing template: %s", err)
-	}
-
-	//contents, err := format.Source(buffer.Bytes())
-	//if err != nil {
-	//	return fmt.Errorf("error formatting generated file: %s", err)
-	//}
-
-	//if _, err := f.Write(contents); err != nil {
-	if _, err := f.Write(buffer.Bytes()); err != nil {
-		f.Close() // ignore error; Write error takes precedence
-		return fmt.Errorf("error writing to file (%s): %s", filename, err)
-	}
-
-	if err := f.Close(); err != nil {
-		return fmt.Errorf("error closing file (%s): %s", filename, err)
-	}
-
-	return nil
-}
@@ -0,0 +1,140 @@
+// Package scaffold provides the helpers shared by the skaff commands.
+package scaffold
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ServiceData holds the names of the service package in which the scaffolding is created.
+type ServiceData struct {
+	ServicePackage string
+	Service        string
+	ServiceLower   string
+	AWSServiceName string
+}
+
+// NewServiceData returns the names of the specified service package, as listed in names/names_data.csv.
+func NewServiceData(servicePackage string) (ServiceData, error) {
+	s, err := names.ProviderNameUpper(servicePackage)
+	if err != nil {
+		return ServiceData{}, fmt.Errorf("error getting service connection name: %w", err)
+	}
+
+	sn, err := names.FullHumanFriendly(servicePackage)
+	if err != nil {
+		return ServiceData{}, fmt.Errorf("error getting AWS service name: %w", err)
+	}
+
+	return ServiceData{
+		ServicePackage: servicePackage,
+		Service:        s,
+		ServiceLower:   strings.ToLower(s),
+		AWSServiceName: sn,
+	}, nil
+}
+
+// CheckName returns an error if the name of the entity or its snake case override are malformed.
+func CheckName(name, snakeName string) error {
+	if name == "" {
+		return fmt.Errorf("error checking: no name given")
+	}
+
+	if name == strings.ToLower(name) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
+		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+	}
+
+	return nil
+}
+
+func ToSnakeCase(upper string, snakeName string) string {
+	if snakeName != "" {
+		return snakeName
+	}
+
+	re := regexp.MustCompile(`([a-z])([A-Z]{2,})`)
+	upper = re.ReplaceAllString(upper, `${1}_${2}`)
+
+	re2 := regexp.MustCompile(`([A-Z][a-z])`)
+	return strings.TrimPrefix(strings.ToLower(re2.ReplaceAllString(upper, `_$1`)), "_")
+}
+
+func WriteTemplate(templateName, filename, tmpl string, force bool, td interface{}) error {
+	if _, err := os.Stat(filename); !os.IsNotExist(err) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening file (%s): %s", filename, err)
+	}
+
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	//contents, err := format.Source(buffer.Bytes())
+	//if err != nil {
+	//	return fmt.Errorf("error formatting generated file: %s", err)
+	//}
+
+	//if _, err := f.Write(contents); err != nil {
+	if _, err := f.Write(buffer.Bytes()); err != nil {
+		f.Close() // ignore error; Write error takes precedence
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error closing file (%s): %s", filename, err)
+	}
+
+	return nil
+}
+
+// InsertSorted inserts the line into the first contiguous run of lines with the specified prefix,
+// keeping the run sorted.
+func InsertSorted(contents, line, prefix string) (string, error) {
+	lines := strings.Split(contents, "\n")
+
+	start := -1
+	end := -1
+	for i, l := range lines {
+		if strings.HasPrefix(l, prefix) {
+			if start == -1 {
+				start = i
+			}
+			end = i + 1
+		} else if start != -1 {
+			break
+		}
+	}
+
+	if start == -1 {
+		return "", fmt.Errorf("no lines beginning with %q", prefix)
+	}
+
+	run := append([]string{line}, lines[start:end]...)
+	sort.Strings(run)
+
+	out := append(append(append([]string{}, lines[:start]...), run...), lines[end:]...)
+
+	return strings.Join(out, "\n"), nil
+}
@@ -0,0 +1,109 @@
+package scaffold
+
+import (
+	"testing"
+)
+
+func TestToSnakeName(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: "",
+		},
+		{
+			TestName: "simple",
+			Input:    "Cheese",
+			Expected: "cheese",
+		},
+		{
+			TestName: "two word",
+			Input:    "CityLights",
+			Expected: "city_lights",
+		},
+		{
+			TestName: "three word",
+			Input:    "OpenEndResource",
+			Expected: "open_end_resource",
+		},
+		{
+			TestName: "initialism",
+			Input:    "DBInstance",
+			Expected: "db_instance",
+		},
+		{
+			TestName: "initialisms",
+			Input:    "DBInstanceVPCEndpoint",
+			Expected: "db_instance_vpc_endpoint",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := ToSnakeCase(testCase.Input, "")
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestInsertSorted(t *testing.T) {
+	const prefix = "\t_ \"pkg/"
+
+	testCases := []struct {
+		TestName string
+		Contents string
+		Line     string
+		Expected string
+		Error    bool
+	}{
+		{
+			TestName: "middle",
+			Contents: "import (\n\t\"testing\"\n\t_ \"pkg/a\"\n\t_ \"pkg/c\"\n\t\"sweep\"\n)\n",
+			Line:     "\t_ \"pkg/b\"",
+			Expected: "import (\n\t\"testing\"\n\t_ \"pkg/a\"\n\t_ \"pkg/b\"\n\t_ \"pkg/c\"\n\t\"sweep\"\n)\n",
+		},
+		{
+			TestName: "first",
+			Contents: "import (\n\t_ \"pkg/b\"\n)\n",
+			Line:     "\t_ \"pkg/a\"",
+			Expected: "import (\n\t_ \"pkg/a\"\n\t_ \"pkg/b\"\n)\n",
+		},
+		{
+			TestName: "last",
+			Contents: "import (\n\t_ \"pkg/a\"\n)\n",
+			Line:     "\t_ \"pkg/b\"",
+			Expected: "import (\n\t_ \"pkg/a\"\n\t_ \"pkg/b\"\n)\n",
+		},
+		{
+			TestName: "no prefix",
+			Contents: "import (\n\t\"testing\"\n)\n",
+			Line:     "\t_ \"pkg/a\"",
+			Error:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := InsertSorted(testCase.Contents, testCase.Line, prefix)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (%s) and no error, expected error", got)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
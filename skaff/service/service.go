@@ -0,0 +1,218 @@
+package service
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/scaffold"
+)
+
+//go:embed generate.tmpl
+var generateTmpl string
+
+//go:embed sweep.tmpl
+var sweepTmpl string
+
+const (
+	namesDataFile  = "names/names_data.csv"
+	awsClientFile  = "internal/conns/awsclient_gen.go"
+	sweepTestFile  = "internal/sweep/sweep_test.go"
+	tagsGenFile    = "internal/generate/tags/main.go"
+	servicesDir    = "internal/service"
+	serviceImport  = "github.com/hashicorp/terraform-provider-aws/internal/service/"
+	tagsMapFlags   = "-ListTags -ServiceTagsMap -UpdateTags"
+	tagsSliceFlags = "-ListTags -ServiceTagsSlice -UpdateTags"
+)
+
+type TemplateData struct {
+	IncludeComments bool
+	ServicePackage  string
+	Service         string
+	ServiceLower    string
+	AWSServiceName  string
+	GoV1Package     string
+	GoV1ClientName  string
+	TagsFlags       string
+}
+
+// Create adds the package of a service, which must already be listed in names/names_data.csv,
+// to the provider in the terraform-provider-aws directory containing the working directory.
+func Create(servicePackage string, tagsSlice, comments, force bool) error {
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	root, err := providerRoot(wd)
+	if err != nil {
+		return err
+	}
+
+	if servicePackage == "" {
+		return fmt.Errorf("error checking: no service package given")
+	}
+
+	sd, err := scaffold.NewServiceData(servicePackage)
+	if err != nil {
+		return fmt.Errorf("service package (%s) not found in %s, add a row for it as described in names/README.md: %w", servicePackage, namesDataFile, err)
+	}
+
+	goV1Package, err := names.AWSGoV1Package(servicePackage)
+	if err != nil {
+		return err
+	}
+
+	goV1ClientName, err := names.AWSGoV1ClientName(servicePackage)
+	if err != nil {
+		return err
+	}
+
+	tagsFlags := tagsMapFlags
+	if tagsSlice {
+		tagsFlags = tagsSliceFlags
+	}
+
+	templateData := TemplateData{
+		IncludeComments: comments,
+		ServicePackage:  sd.ServicePackage,
+		Service:         sd.Service,
+		ServiceLower:    sd.ServiceLower,
+		AWSServiceName:  sd.AWSServiceName,
+		GoV1Package:     goV1Package,
+		GoV1ClientName:  goV1ClientName,
+		TagsFlags:       tagsFlags,
+	}
+
+	if err := addClient(root, templateData); err != nil {
+		return err
+	}
+
+	if err := addTagsServiceName(root, templateData); err != nil {
+		return err
+	}
+
+	dir := filepath.Join(root, servicesDir, servicePackage)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("error creating directory (%s): %w", dir, err)
+	}
+
+	if err = scaffold.WriteTemplate("generate", filepath.Join(dir, "generate.go"), generateTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing generate template: %w", err)
+	}
+
+	if err = scaffold.WriteTemplate("sweep", filepath.Join(dir, "sweep.go"), sweepTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing sweep template: %w", err)
+	}
+
+	if err := addSweepImport(filepath.Join(root, sweepTestFile), servicePackage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// providerRoot returns the terraform-provider-aws directory containing the specified directory.
+func providerRoot(dir string) (string, error) {
+	for d := dir; ; d = filepath.Dir(d) {
+		if _, err := os.Stat(filepath.Join(d, namesDataFile)); err == nil {
+			return d, nil
+		}
+
+		if d == filepath.Dir(d) {
+			return "", fmt.Errorf("%s not found in any parent of %s, run skaff in the terraform-provider-aws directory", namesDataFile, dir)
+		}
+	}
+}
+
+// addClient generates the service's AWS Go SDK client in the conns package, unless it already exists.
+func addClient(root string, td TemplateData) error {
+	field := regexp.MustCompile(fmt.Sprintf(`(?m)^\s+%sConn\s`, regexp.QuoteMeta(td.Service)))
+
+	for i := 0; i < 2; i++ {
+		b, err := os.ReadFile(filepath.Join(root, awsClientFile))
+		if err != nil {
+			return fmt.Errorf("error reading %s: %w", awsClientFile, err)
+		}
+
+		if field.Match(b) {
+			return nil
+		}
+
+		if i > 0 {
+			break
+		}
+
+		cmd := exec.Command("go", "generate", "./internal/conns")
+		cmd.Dir = root
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("error generating AWS clients: %w", err)
+		}
+	}
+
+	return fmt.Errorf("%sConn not generated in %s, check that SkipClientGenerate is blank for %s in %s", td.Service, awsClientFile, td.ServicePackage, namesDataFile)
+}
+
+// addTagsServiceName adds the service's AWS Go SDK client name to the tags generator, unless it already exists.
+func addTagsServiceName(root string, td TemplateData) error {
+	filename := filepath.Join(root, tagsGenFile)
+
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", tagsGenFile, err)
+	}
+
+	if bytes.Contains(b, []byte(fmt.Sprintf("awsServiceNames[%q]", td.ServicePackage))) || bytes.Contains(b, []byte(fmt.Sprintf("case %q:", td.ServicePackage))) {
+		return nil
+	}
+
+	if td.GoV1Package != td.ServicePackage {
+		return fmt.Errorf("service package (%s) differs from AWS Go SDK package (%s), add it to awsServiceName and awsServiceNameUpper in %s", td.ServicePackage, td.GoV1Package, tagsGenFile)
+	}
+
+	line := fmt.Sprintf("\tawsServiceNames[%q] = %q", td.ServicePackage, td.GoV1ClientName)
+
+	out, err := scaffold.InsertSorted(string(b), line, "\tawsServiceNames[\"")
+	if err != nil {
+		return fmt.Errorf("error adding %s to %s: %w", td.ServicePackage, tagsGenFile, err)
+	}
+
+	if err := os.WriteFile(filename, []byte(out), 0644); err != nil {
+		return fmt.Errorf("error writing %s: %w", tagsGenFile, err)
+	}
+
+	return nil
+}
+
+// addSweepImport adds the service package to the packages imported for their sweepers, unless it already is.
+func addSweepImport(filename, servicePackage string) error {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", sweepTestFile, err)
+	}
+
+	line := fmt.Sprintf("\t_ %q", serviceImport+servicePackage)
+
+	if bytes.Contains(b, []byte(line+"\n")) {
+		return nil
+	}
+
+	out, err := scaffold.InsertSorted(string(b), line, "\t_ \""+serviceImport)
+	if err != nil {
+		return fmt.Errorf("error adding %s to %s: %w", servicePackage, sweepTestFile, err)
+	}
+
+	if err := os.WriteFile(filename, []byte(out), 0644); err != nil {
+		return fmt.Errorf("error writing %s: %w", sweepTestFile, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package tag
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/scaffold"
+)
+
+//go:embed tagtest.tmpl
+var tagTestTmpl string
+
+//go:embed websitedoc.tmpl
+var websiteTmpl string
+
+const (
+	DefaultIDAttribName  = "resource_arn"
+	generateFile         = "generate.go"
+	tagResourceGenFile   = "internal/generate/tagresource/main.go"
+	tagResourceDirective = "//go:generate go run ../../generate/tagresource/main.go"
+	tagsDirective        = "//go:generate go run ../../generate/tags/main.go"
+)
+
+type TemplateData struct {
+	IncludeComments bool
+	ServicePackage  string
+	Service         string
+	ServiceLower    string
+	AWSServiceName  string
+	GoV1Package     string
+	IDAttribName    string
+}
+
+// Create adds a resource managing an individual resource tag, e.g. aws_ecs_tag, to the service
+// package in the working directory. The resource itself is generated by internal/generate/tagresource
+// from the directive added to generate.go, so only its acceptance tests and website doc are written.
+func Create(idAttribName string, comments, force bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if idAttribName == "" {
+		idAttribName = DefaultIDAttribName
+	}
+
+	if idAttribName != strings.ToLower(idAttribName) {
+		return fmt.Errorf("error checking: ID attribute name should be all lower case with underscores, if needed (e.g., resource_id)")
+	}
+
+	sd, err := scaffold.NewServiceData(servicePackage)
+	if err != nil {
+		return err
+	}
+
+	goV1Package, err := names.AWSGoV1Package(servicePackage)
+	if err != nil {
+		return err
+	}
+
+	templateData := TemplateData{
+		IncludeComments: comments,
+		ServicePackage:  sd.ServicePackage,
+		Service:         sd.Service,
+		ServiceLower:    sd.ServiceLower,
+		AWSServiceName:  sd.AWSServiceName,
+		GoV1Package:     goV1Package,
+		IDAttribName:    idAttribName,
+	}
+
+	if err := addTagResourceServiceName(filepath.Join("..", "..", "..", tagResourceGenFile), templateData); err != nil {
+		return err
+	}
+
+	if err := addGenerateDirectives(generateFile, idAttribName); err != nil {
+		return err
+	}
+
+	if err = scaffold.WriteTemplate("tagtest", "tag_test.go", tagTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing tag test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_tag.html.markdown", servicePackage)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = scaffold.WriteTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing tag website doc template: %w", err)
+	}
+
+	return nil
+}
+
+// addTagResourceServiceName adds the service's client name to the tag resource generator, unless it already exists.
+func addTagResourceServiceName(filename string, td TemplateData) error {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("error reading %s, run skaff in the service package directory: %w", tagResourceGenFile, err)
+	}
+
+	if bytes.Contains(b, []byte(fmt.Sprintf("awsServiceNames[%q]", td.ServicePackage))) {
+		return nil
+	}
+
+	if td.GoV1Package != td.ServicePackage {
+		return fmt.Errorf("service package (%s) differs from AWS Go SDK package (%s), add it to awsServiceName and awsServiceNameUpper in %s", td.ServicePackage, td.GoV1Package, tagResourceGenFile)
+	}
+
+	line := fmt.Sprintf("\tawsServiceNames[%q] = %q", td.ServicePackage, td.Service)
+
+	out, err := scaffold.InsertSorted(string(b), line, "\tawsServiceNames[\"")
+	if err != nil {
+		return fmt.Errorf("error adding %s to %s: %w", td.ServicePackage, tagResourceGenFile, err)
+	}
+
+	if err := os.WriteFile(filename, []byte(out), 0644); err != nil {
+		return fmt.Errorf("error writing %s: %w", tagResourceGenFile, err)
+	}
+
+	return nil
+}
+
+// addGenerateDirectives adds the tag resource generation directive to the service's generate.go.
+func addGenerateDirectives(filename, idAttribName string) error {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("error reading %s, run skaff in the service package directory: %w", filename, err)
+	}
+
+	out, err := addTagResourceDirective(string(b), idAttribName)
+	if err != nil {
+		return fmt.Errorf("error adding tag resource generation to %s: %w", filename, err)
+	}
+
+	if err := os.WriteFile(filename, []byte(out), 0644); err != nil {
+		return fmt.Errorf("error writing %s: %w", filename, err)
+	}
+
+	return nil
+}
+
+// addTagResourceDirective inserts the tag resource generation directive before the tags generation
+// directive, unless it already exists, and adds the -GetTag flag used by the tag resource to the latter.
+func addTagResourceDirective(contents, idAttribName string) (string, error) {
+	lines := strings.Split(contents, "\n")
+
+	tags := -1
+	for i, l := range lines {
+		if strings.HasPrefix(l, tagsDirective+" ") || l == tagsDirective {
+			tags = i
+			break
+		}
+	}
+
+	if tags == -1 {
+		return "", fmt.Errorf("no tags generation directive, add one as described in internal/generate/tags/README.md")
+	}
+
+	flags := strings.Fields(strings.TrimPrefix(lines[tags], tagsDirective))
+
+	if !hasFlag(flags, "-UpdateTags") {
+		return "", fmt.Errorf("tags generation directive has no -UpdateTags flag, which the tag resource requires")
+	}
+
+	if !hasFlag(flags, "-GetTag") {
+		lines[tags] = tagsDirective + " -GetTag" + strings.TrimPrefix(lines[tags], tagsDirective)
+	}
+
+	for _, l := range lines {
+		if strings.HasPrefix(l, tagResourceDirective) {
+			return strings.Join(lines, "\n"), nil
+		}
+	}
+
+	directive := tagResourceDirective
+	if idAttribName != DefaultIDAttribName {
+		directive += " -IDAttribName=" + idAttribName
+	}
+
+	out := append(append(append([]string{}, lines[:tags]...), directive), lines[tags:]...)
+
+	return strings.Join(out, "\n"), nil
+}
+
+func hasFlag(flags []string, flag string) bool {
+	for _, f := range flags {
+		if f == flag || strings.HasPrefix(f, flag+"=") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,134 @@
+package tag
+
+import (
+	"go/format"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/scaffold"
+)
+
+func TestAddTagResourceDirective(t *testing.T) {
+	const pkg = "\n// ONLY generate directives and package declaration! Do not add anything else to this file.\n\npackage mq\n"
+
+	testCases := []struct {
+		TestName     string
+		Contents     string
+		IDAttribName string
+		Expected     string
+		Error        bool
+	}{
+		{
+			TestName:     "add",
+			Contents:     "//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsMap -UpdateTags" + pkg,
+			IDAttribName: DefaultIDAttribName,
+			Expected:     "//go:generate go run ../../generate/tagresource/main.go\n//go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ServiceTagsMap -UpdateTags" + pkg,
+		},
+		{
+			TestName:     "ID attribute name",
+			Contents:     "//go:generate go run ../../generate/listpages/main.go -ListOps=ListBrokers\n//go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ServiceTagsMap -UpdateTags" + pkg,
+			IDAttribName: "resource_id",
+			Expected:     "//go:generate go run ../../generate/listpages/main.go -ListOps=ListBrokers\n//go:generate go run ../../generate/tagresource/main.go -IDAttribName=resource_id\n//go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ServiceTagsMap -UpdateTags" + pkg,
+		},
+		{
+			TestName:     "exists",
+			Contents:     "//go:generate go run ../../generate/tagresource/main.go\n//go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ServiceTagsMap -UpdateTags" + pkg,
+			IDAttribName: DefaultIDAttribName,
+			Expected:     "//go:generate go run ../../generate/tagresource/main.go\n//go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ServiceTagsMap -UpdateTags" + pkg,
+		},
+		{
+			TestName:     "no UpdateTags",
+			Contents:     "//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsMap" + pkg,
+			IDAttribName: DefaultIDAttribName,
+			Error:        true,
+		},
+		{
+			TestName:     "no tags",
+			Contents:     "//go:generate go run ../../generate/listpages/main.go -ListOps=ListBrokers" + pkg,
+			IDAttribName: DefaultIDAttribName,
+			Error:        true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := addTagResourceDirective(testCase.Contents, testCase.IDAttribName)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (%s) and no error, expected error", got)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestTemplates(t *testing.T) {
+	sd, err := scaffold.NewServiceData("mq")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, idAttribName := range []string{DefaultIDAttribName, "resource_id"} {
+		for _, comments := range []bool{false, true} {
+			td := TemplateData{
+				IncludeComments: comments,
+				ServicePackage:  sd.ServicePackage,
+				Service:         sd.Service,
+				ServiceLower:    sd.ServiceLower,
+				AWSServiceName:  sd.AWSServiceName,
+				GoV1Package:     "mq",
+				IDAttribName:    idAttribName,
+			}
+			dir := t.TempDir()
+
+			for _, tmpl := range []struct {
+				Name   string
+				Text   string
+				GoFile bool
+			}{
+				{Name: "tagtest", Text: tagTestTmpl, GoFile: true},
+				{Name: "websitedoc", Text: websiteTmpl},
+			} {
+				filename := filepath.Join(dir, tmpl.Name)
+
+				if err := scaffold.WriteTemplate(tmpl.Name, filename, tmpl.Text, false, td); err != nil {
+					t.Fatalf("%s (%s, comments: %t): %s", tmpl.Name, idAttribName, comments, err)
+				}
+
+				b, err := os.ReadFile(filename)
+
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !strings.Contains(string(b), idAttribName+" = aws_mq_example.") {
+					t.Errorf("%s (%s, comments: %t): expected %s argument", tmpl.Name, idAttribName, comments, idAttribName)
+				}
+
+				if !tmpl.GoFile {
+					continue
+				}
+
+				formatted, err := format.Source(b)
+
+				if err != nil {
+					t.Errorf("%s (%s, comments: %t): formatting: %s\n%s", tmpl.Name, idAttribName, comments, err, b)
+				}
+
+				if string(formatted) != string(b) {
+					t.Errorf("%s (%s, comments: %t): not formatted:\n%s", tmpl.Name, idAttribName, comments, b)
+				}
+			}
+		}
+	}
+}